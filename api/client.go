@@ -0,0 +1,96 @@
+// Package api provides a slack Web API client implementation which
+// authenticates requests using a bot or user token.
+//
+// See: https://api.slack.com/web
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	neturl "net/url"
+
+	"github.com/multiplay/go-slack"
+)
+
+// Client is a slack Web API client for calling API methods using a token.
+type Client struct {
+	slack.HTTPConfig
+
+	// Token is the bot or user token sent as the Authorization Bearer token.
+	Token string
+}
+
+// New returns a new Client which sends requests authenticated with token
+// configured by opts.
+func New(token string, opts ...slack.Option) *Client {
+	c := &Client{Token: token}
+	c.Apply(opts...)
+
+	return c
+}
+
+// Send sends the request to the slack Web API method url.
+// The msg is sent as the JSON body of the request, or form encoded if it's
+// a url.Values as required by methods which don't accept JSON such as
+// conversations.replies. The JSON response is decoded into resp which must
+// implement slack.SendResponse.
+func (c *Client) Send(url string, msg, resp interface{}) error {
+	return c.SendContext(context.Background(), url, msg, resp)
+}
+
+// SendContext sends the request to the slack Web API method url
+// using ctx to control the lifetime of the request.
+func (c *Client) SendContext(ctx context.Context, url string, msg, resp interface{}) error {
+	b, ct, err := encode(msg)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", ct)
+	if c.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+
+	r, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	defer r.Body.Close()
+
+	if r.StatusCode != http.StatusOK {
+		return slack.NewHTTPError(r)
+	}
+
+	dec := json.NewDecoder(r.Body)
+	if err := dec.Decode(resp); err != nil {
+		return slack.NewDecodeError(r.StatusCode, err)
+	}
+
+	if sr, ok := resp.(slack.SendResponse); !ok {
+		return slack.NewError(r.StatusCode, "not a response")
+	} else if !sr.Ok() {
+		return slack.NewError(r.StatusCode, sr.Err())
+	}
+
+	return nil
+}
+
+// encode returns the body and content type of the request for msg.
+func encode(msg interface{}) ([]byte, string, error) {
+	if v, ok := msg.(neturl.Values); ok {
+		return []byte(v.Encode()), "application/x-www-form-urlencoded", nil
+	}
+
+	b, err := json.Marshal(msg)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return b, "application/json; charset=utf-8", nil
+}
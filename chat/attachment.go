@@ -1,13 +1,17 @@
 package chat
 
+import (
+	"encoding/json"
+)
+
 // Attachment is a slack chat message attachment.
 // See: https://api.slack.com/docs/message-attachments
 type Attachment struct {
 	// Fallback is the plain-text summary of the attachment.
-	Fallback string `json:"fallback"`
+	Fallback string `json:"fallback,omitempty"`
 
 	// Color is a color indicating the classification of the message.
-	Color string `json:"color"`
+	Color string `json:"color,omitempty"`
 
 	// PreText is optional text that appears above the message attachment block.
 	PreText string `json:"pretext,omitempty"`
@@ -33,24 +37,50 @@ type Attachment struct {
 	// Fields contains optional fields to be displayed in the in a table inside the attachment.
 	Fields []*Field `json:"fields,omitempty"`
 
+	// Blocks are the Block Kit layout blocks of the attachment.
+	Blocks Blocks `json:"blocks,omitempty"`
+
 	// MarkdownIn enables Markdown support. Valid values are ["pretext", "text", "fields"].
 	// Setting "fields" will enable markup formatting for the value of each field.
 	MarkdownIn []string `json:"mrkdwn_in,omitempty"`
 
 	// ImageURL is the URL to an image file that will be displayed inside the attachment.
-	ImageURL string `json:"image_url"`
+	ImageURL string `json:"image_url,omitempty"`
 
 	// ThumbURL is the URL to an image file that will be displayed as a thumbnail on the right side of a attachment.
-	ThumbURL string `json:"ThumbURL"`
+	ThumbURL string `json:"ThumbURL,omitempty"`
 
 	// Footer is optional text to help contextualize and identify an attachment (300 chars max).
-	Footer string `json:"footer"`
+	Footer string `json:"footer,omitempty"`
 
 	// FooterIcon is the URL to a small icon beside your footer text.
-	FooterIcon string `json:"footer_icon"`
+	FooterIcon string `json:"footer_icon,omitempty"`
 
 	// TimeStamp if set is the epoch time that will display as part of the attachment's footer.
 	TimeStamp int `json:"ts,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which aren't otherwise
+	// encoded, such as fields which Attachment doesn't model. They're included
+	// when the attachment is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (a Attachment) MarshalJSON() ([]byte, error) {
+	type alias Attachment
+	return marshalExtra(alias(a), a.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (a *Attachment) UnmarshalJSON(data []byte) error {
+	type alias Attachment
+	extra, err := unmarshalExtra(data, (*alias)(a))
+	if err != nil {
+		return err
+	}
+	a.Extra = extra
+
+	return nil
 }
 
 // NewField creates a new field, adds it to the attachment and then returns it.
@@ -65,3 +95,8 @@ func (a *Attachment) NewField(title, value string) *Field {
 func (a *Attachment) AddField(f *Field) {
 	a.Fields = append(a.Fields, f)
 }
+
+// AddBlock adds b to the attachment's blocks.
+func (a *Attachment) AddBlock(b Block) {
+	a.Blocks = append(a.Blocks, b)
+}
@@ -29,3 +29,14 @@ func TestAttachmentNewFieldLong(t *testing.T) {
 	assert.False(t, f.Short)
 	assert.Equal(t, 1, len(a.Fields))
 }
+
+func TestAttachmentAddBlock(t *testing.T) {
+	a := &Attachment{}
+	b := &DividerBlock{}
+	a.AddBlock(b)
+
+	if !assert.Equal(t, 1, len(a.Blocks)) {
+		return
+	}
+	assert.Equal(t, b, a.Blocks[0])
+}
@@ -0,0 +1,446 @@
+package chat
+
+import (
+	"encoding/json"
+)
+
+const (
+	// BlockTypeSection is the type of a SectionBlock.
+	BlockTypeSection = "section"
+
+	// BlockTypeDivider is the type of a DividerBlock.
+	BlockTypeDivider = "divider"
+
+	// BlockTypeHeader is the type of a HeaderBlock.
+	BlockTypeHeader = "header"
+
+	// BlockTypeContext is the type of a ContextBlock.
+	BlockTypeContext = "context"
+
+	// BlockTypeImage is the type of an ImageBlock.
+	BlockTypeImage = "image"
+
+	// BlockTypeActions is the type of an ActionsBlock.
+	BlockTypeActions = "actions"
+
+	// BlockTypeInput is the type of an InputBlock.
+	BlockTypeInput = "input"
+
+	// BlockTypeFile is the type of a FileBlock.
+	BlockTypeFile = "file"
+
+	// BlockTypeRichText is the type of a RichTextBlock.
+	BlockTypeRichText = "rich_text"
+)
+
+// blockTypes maps block types to a function which creates a block of that type.
+var blockTypes = map[string]func() Block{
+	BlockTypeSection:  func() Block { return &SectionBlock{} },
+	BlockTypeDivider:  func() Block { return &DividerBlock{} },
+	BlockTypeHeader:   func() Block { return &HeaderBlock{} },
+	BlockTypeContext:  func() Block { return &ContextBlock{} },
+	BlockTypeImage:    func() Block { return &ImageBlock{} },
+	BlockTypeActions:  func() Block { return &ActionsBlock{} },
+	BlockTypeInput:    func() Block { return &InputBlock{} },
+	BlockTypeFile:     func() Block { return &FileBlock{} },
+	BlockTypeRichText: func() Block { return &RichTextBlock{} },
+}
+
+// Block is a Block Kit layout block.
+// The JSON type field of a block is determined by its Go type.
+// See: https://api.slack.com/reference/block-kit/blocks
+type Block interface {
+	// BlockType returns the Block Kit type of the block e.g. section.
+	BlockType() string
+}
+
+// Blocks is a list of blocks which decodes each block from JSON according to its type.
+type Blocks []Block
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *Blocks) UnmarshalJSON(data []byte) error {
+	s, err := decodeTypedSlice(data, blockTypes, "block")
+	if err != nil {
+		return err
+	}
+	*b = s
+
+	return nil
+}
+
+// SectionBlock displays text, optionally with fields and an accessory element.
+// See: https://api.slack.com/reference/block-kit/blocks#section
+type SectionBlock struct {
+	// BlockID is an optional unique identifier of the block.
+	BlockID string `json:"block_id,omitempty"`
+
+	// Text is the text of the section, required unless Fields is set.
+	Text *TextObject `json:"text,omitempty"`
+
+	// Fields are text objects displayed in a compact two column format.
+	Fields []*TextObject `json:"fields,omitempty"`
+
+	// Accessory is an optional element displayed alongside the text.
+	Accessory Element `json:"accessory,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which SectionBlock doesn't model.
+	// They're included when the block is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// BlockType implements Block.
+func (SectionBlock) BlockType() string {
+	return BlockTypeSection
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b SectionBlock) MarshalJSON() ([]byte, error) {
+	type alias SectionBlock
+	return marshalTyped(b.BlockType(), alias(b), b.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *SectionBlock) UnmarshalJSON(data []byte) error {
+	type alias SectionBlock
+	v := struct {
+		*alias
+		Accessory json.RawMessage `json:"accessory"`
+	}{alias: (*alias)(b)}
+	extra, err := unmarshalTyped(data, &v)
+	if err != nil {
+		return err
+	}
+
+	e, err := decodeTyped(v.Accessory, elementTypes, "element")
+	if err != nil {
+		return err
+	}
+	b.Accessory = e
+	b.Extra = extra
+
+	return nil
+}
+
+// DividerBlock is a visual separator between blocks.
+// See: https://api.slack.com/reference/block-kit/blocks#divider
+type DividerBlock struct {
+	// BlockID is an optional unique identifier of the block.
+	BlockID string `json:"block_id,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which DividerBlock doesn't model.
+	// They're included when the block is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// BlockType implements Block.
+func (DividerBlock) BlockType() string {
+	return BlockTypeDivider
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b DividerBlock) MarshalJSON() ([]byte, error) {
+	type alias DividerBlock
+	return marshalTyped(b.BlockType(), alias(b), b.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *DividerBlock) UnmarshalJSON(data []byte) error {
+	type alias DividerBlock
+	extra, err := unmarshalTyped(data, (*alias)(b))
+	if err != nil {
+		return err
+	}
+	b.Extra = extra
+
+	return nil
+}
+
+// HeaderBlock displays plain text in a larger, bold font.
+// See: https://api.slack.com/reference/block-kit/blocks#header
+type HeaderBlock struct {
+	// BlockID is an optional unique identifier of the block.
+	BlockID string `json:"block_id,omitempty"`
+
+	// Text is the plain text of the header.
+	Text *TextObject `json:"text"`
+
+	// Extra contains the fields of the decoded JSON which HeaderBlock doesn't model.
+	// They're included when the block is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// BlockType implements Block.
+func (HeaderBlock) BlockType() string {
+	return BlockTypeHeader
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b HeaderBlock) MarshalJSON() ([]byte, error) {
+	type alias HeaderBlock
+	return marshalTyped(b.BlockType(), alias(b), b.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *HeaderBlock) UnmarshalJSON(data []byte) error {
+	type alias HeaderBlock
+	extra, err := unmarshalTyped(data, (*alias)(b))
+	if err != nil {
+		return err
+	}
+	b.Extra = extra
+
+	return nil
+}
+
+// ContextBlock displays contextual information as small images and text.
+// See: https://api.slack.com/reference/block-kit/blocks#context
+type ContextBlock struct {
+	// BlockID is an optional unique identifier of the block.
+	BlockID string `json:"block_id,omitempty"`
+
+	// Elements are the text objects and image elements of the block.
+	Elements ContextElements `json:"elements"`
+
+	// Extra contains the fields of the decoded JSON which ContextBlock doesn't model.
+	// They're included when the block is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// BlockType implements Block.
+func (ContextBlock) BlockType() string {
+	return BlockTypeContext
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b ContextBlock) MarshalJSON() ([]byte, error) {
+	type alias ContextBlock
+	return marshalTyped(b.BlockType(), alias(b), b.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *ContextBlock) UnmarshalJSON(data []byte) error {
+	type alias ContextBlock
+	extra, err := unmarshalTyped(data, (*alias)(b))
+	if err != nil {
+		return err
+	}
+	b.Extra = extra
+
+	return nil
+}
+
+// ImageBlock displays an image.
+// See: https://api.slack.com/reference/block-kit/blocks#image
+type ImageBlock struct {
+	// BlockID is an optional unique identifier of the block.
+	BlockID string `json:"block_id,omitempty"`
+
+	// ImageURL is the URL of the image.
+	ImageURL string `json:"image_url"`
+
+	// AltText is a plain text summary of the image.
+	AltText string `json:"alt_text"`
+
+	// Title is an optional plain text title shown above the image.
+	Title *TextObject `json:"title,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which ImageBlock doesn't model.
+	// They're included when the block is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// BlockType implements Block.
+func (ImageBlock) BlockType() string {
+	return BlockTypeImage
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b ImageBlock) MarshalJSON() ([]byte, error) {
+	type alias ImageBlock
+	return marshalTyped(b.BlockType(), alias(b), b.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *ImageBlock) UnmarshalJSON(data []byte) error {
+	type alias ImageBlock
+	extra, err := unmarshalTyped(data, (*alias)(b))
+	if err != nil {
+		return err
+	}
+	b.Extra = extra
+
+	return nil
+}
+
+// ActionsBlock holds interactive elements.
+// See: https://api.slack.com/reference/block-kit/blocks#actions
+type ActionsBlock struct {
+	// BlockID is an optional unique identifier of the block.
+	BlockID string `json:"block_id,omitempty"`
+
+	// Elements are the interactive elements of the block.
+	Elements Elements `json:"elements"`
+
+	// Extra contains the fields of the decoded JSON which ActionsBlock doesn't model.
+	// They're included when the block is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// BlockType implements Block.
+func (ActionsBlock) BlockType() string {
+	return BlockTypeActions
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b ActionsBlock) MarshalJSON() ([]byte, error) {
+	type alias ActionsBlock
+	return marshalTyped(b.BlockType(), alias(b), b.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *ActionsBlock) UnmarshalJSON(data []byte) error {
+	type alias ActionsBlock
+	extra, err := unmarshalTyped(data, (*alias)(b))
+	if err != nil {
+		return err
+	}
+	b.Extra = extra
+
+	return nil
+}
+
+// InputBlock collects information from users using a single element.
+// See: https://api.slack.com/reference/block-kit/blocks#input
+type InputBlock struct {
+	// BlockID is an optional unique identifier of the block.
+	BlockID string `json:"block_id,omitempty"`
+
+	// Label is the plain text label of the input.
+	Label *TextObject `json:"label"`
+
+	// Element is the input element.
+	Element Element `json:"element"`
+
+	// DispatchAction if true causes the element to send a block_actions payload when used.
+	DispatchAction bool `json:"dispatch_action,omitempty"`
+
+	// Hint is optional plain text shown below the input.
+	Hint *TextObject `json:"hint,omitempty"`
+
+	// Optional if true allows the input to be empty when submitted.
+	Optional bool `json:"optional,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which InputBlock doesn't model.
+	// They're included when the block is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// BlockType implements Block.
+func (InputBlock) BlockType() string {
+	return BlockTypeInput
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b InputBlock) MarshalJSON() ([]byte, error) {
+	type alias InputBlock
+	return marshalTyped(b.BlockType(), alias(b), b.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *InputBlock) UnmarshalJSON(data []byte) error {
+	type alias InputBlock
+	v := struct {
+		*alias
+		Element json.RawMessage `json:"element"`
+	}{alias: (*alias)(b)}
+	extra, err := unmarshalTyped(data, &v)
+	if err != nil {
+		return err
+	}
+
+	e, err := decodeTyped(v.Element, elementTypes, "element")
+	if err != nil {
+		return err
+	}
+	b.Element = e
+	b.Extra = extra
+
+	return nil
+}
+
+// FileBlock displays a remote file.
+// See: https://api.slack.com/reference/block-kit/blocks#file
+type FileBlock struct {
+	// BlockID is an optional unique identifier of the block.
+	BlockID string `json:"block_id,omitempty"`
+
+	// ExternalID is the external unique ID of the file.
+	ExternalID string `json:"external_id"`
+
+	// Source is always "remote" for remote files.
+	Source string `json:"source"`
+
+	// Extra contains the fields of the decoded JSON which FileBlock doesn't model.
+	// They're included when the block is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// BlockType implements Block.
+func (FileBlock) BlockType() string {
+	return BlockTypeFile
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b FileBlock) MarshalJSON() ([]byte, error) {
+	type alias FileBlock
+	return marshalTyped(b.BlockType(), alias(b), b.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *FileBlock) UnmarshalJSON(data []byte) error {
+	type alias FileBlock
+	extra, err := unmarshalTyped(data, (*alias)(b))
+	if err != nil {
+		return err
+	}
+	b.Extra = extra
+
+	return nil
+}
+
+// RichTextBlock displays formatted, structured text.
+// See: https://api.slack.com/reference/block-kit/blocks#rich_text
+type RichTextBlock struct {
+	// BlockID is an optional unique identifier of the block.
+	BlockID string `json:"block_id,omitempty"`
+
+	// Elements are the sections, lists, preformatted and quote elements of the block.
+	Elements RichTextElements `json:"elements"`
+
+	// Extra contains the fields of the decoded JSON which RichTextBlock doesn't model.
+	// They're included when the block is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// BlockType implements Block.
+func (RichTextBlock) BlockType() string {
+	return BlockTypeRichText
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b RichTextBlock) MarshalJSON() ([]byte, error) {
+	type alias RichTextBlock
+	return marshalTyped(b.BlockType(), alias(b), b.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *RichTextBlock) UnmarshalJSON(data []byte) error {
+	type alias RichTextBlock
+	extra, err := unmarshalTyped(data, (*alias)(b))
+	if err != nil {
+		return err
+	}
+	b.Extra = extra
+
+	return nil
+}
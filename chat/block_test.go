@@ -0,0 +1,117 @@
+package chat
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testBlocks is a message containing every block type and its expected JSON encoding.
+var (
+	testBlocks = Blocks{
+		&HeaderBlock{Text: NewPlainText("Deploy")},
+		&SectionBlock{
+			BlockID:   "summary",
+			Text:      NewMarkdownText("*api* deployed"),
+			Fields:    []*TextObject{NewMarkdownText("*Env*\nprod"), NewMarkdownText("*Version*\n1.2.3")},
+			Accessory: &ImageElement{ImageURL: "https://example.com/icon.png", AltText: "icon"},
+		},
+		&DividerBlock{},
+		&ContextBlock{Elements: ContextElements{
+			&ImageElement{ImageURL: "https://example.com/user.png", AltText: "user"},
+			NewPlainText("by ci"),
+		}},
+		&ImageBlock{ImageURL: "https://example.com/graph.png", AltText: "graph", Title: NewPlainText("Latency")},
+		&ActionsBlock{BlockID: "actions", Elements: Elements{
+			&ImageElement{ImageURL: "https://example.com/a.png", AltText: "a"},
+		}},
+		&InputBlock{
+			Label:    NewPlainText("Reason"),
+			Element:  &ImageElement{ImageURL: "https://example.com/b.png", AltText: "b"},
+			Optional: true,
+		},
+		&FileBlock{ExternalID: "ABCD1", Source: "remote"},
+		&RichTextBlock{Elements: RichTextElements{
+			&RichTextSection{Elements: RichTextInlines{&RichTextText{Text: "hello"}}},
+		}},
+	}
+
+	testBlocksJSON = `[
+		{"type":"header","text":{"type":"plain_text","text":"Deploy"}},
+		{
+			"type":"section",
+			"block_id":"summary",
+			"text":{"type":"mrkdwn","text":"*api* deployed"},
+			"fields":[{"type":"mrkdwn","text":"*Env*\nprod"},{"type":"mrkdwn","text":"*Version*\n1.2.3"}],
+			"accessory":{"type":"image","image_url":"https://example.com/icon.png","alt_text":"icon"}
+		},
+		{"type":"divider"},
+		{"type":"context","elements":[
+			{"type":"image","image_url":"https://example.com/user.png","alt_text":"user"},
+			{"type":"plain_text","text":"by ci"}
+		]},
+		{"type":"image","image_url":"https://example.com/graph.png","alt_text":"graph","title":{"type":"plain_text","text":"Latency"}},
+		{"type":"actions","block_id":"actions","elements":[
+			{"type":"image","image_url":"https://example.com/a.png","alt_text":"a"}
+		]},
+		{
+			"type":"input",
+			"label":{"type":"plain_text","text":"Reason"},
+			"element":{"type":"image","image_url":"https://example.com/b.png","alt_text":"b"},
+			"optional":true
+		},
+		{"type":"file","external_id":"ABCD1","source":"remote"},
+		{"type":"rich_text","elements":[
+			{"type":"rich_text_section","elements":[{"type":"text","text":"hello"}]}
+		]}
+	]`
+)
+
+func TestBlocksMarshal(t *testing.T) {
+	b, err := json.Marshal(testBlocks)
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, testBlocksJSON, string(b))
+	}
+}
+
+func TestBlocksUnmarshal(t *testing.T) {
+	var b Blocks
+	if assert.NoError(t, json.Unmarshal([]byte(testBlocksJSON), &b)) {
+		assert.Equal(t, testBlocks, b)
+	}
+}
+
+func TestBlocksUnmarshalError(t *testing.T) {
+	var b Blocks
+	assert.Error(t, json.Unmarshal([]byte(`{}`), &b))
+	assert.Error(t, json.Unmarshal([]byte(`[{"type":"section","accessory":[]}]`), &b))
+	assert.Error(t, json.Unmarshal([]byte(`[{"type":"section","text":1}]`), &b))
+	assert.Error(t, json.Unmarshal([]byte(`[{"type":"input","element":1}]`), &b))
+	assert.Error(t, json.Unmarshal([]byte(`[{"type":"input","label":1}]`), &b))
+}
+
+func TestBlocksUnknown(t *testing.T) {
+	data := `[{"type":"video","title":{"type":"plain_text","text":"t"}},{"type":"section","accessory":{"type":"workflow_button","text":"x"}}]`
+	var b Blocks
+	if !assert.NoError(t, json.Unmarshal([]byte(data), &b)) || !assert.Len(t, b, 2) {
+		return
+	}
+	assert.Equal(t, "video", b[0].BlockType())
+	if s, ok := b[1].(*SectionBlock); assert.True(t, ok) {
+		assert.Equal(t, "workflow_button", s.Accessory.ElementType())
+	}
+
+	out, err := json.Marshal(b)
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, data, string(out))
+	}
+}
+
+func TestBlockValues(t *testing.T) {
+	// Blocks can be used as values as well as pointers.
+	b, err := json.Marshal(Blocks{DividerBlock{BlockID: "d1"}})
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, `[{"type":"divider","block_id":"d1"}]`, string(b))
+	}
+}
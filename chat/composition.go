@@ -0,0 +1,271 @@
+package chat
+
+import (
+	"encoding/json"
+)
+
+const (
+	// PlainTextType is the type of a plain text TextObject.
+	PlainTextType = "plain_text"
+
+	// MarkdownType is the type of a mrkdwn formatted TextObject.
+	MarkdownType = "mrkdwn"
+)
+
+// TextObject is a Block Kit text composition object.
+// See: https://api.slack.com/reference/block-kit/composition-objects#text
+type TextObject struct {
+	// Type is the formatting to use, either PlainTextType or MarkdownType.
+	Type string `json:"type"`
+
+	// Text is the text of the object.
+	Text string `json:"text"`
+
+	// Emoji indicates if emojis in plain text should be escaped into the colon emoji format.
+	// If nil slack's default of true is used.
+	Emoji *bool `json:"emoji,omitempty"`
+
+	// Verbatim if true disables the automatic linking of URLs, channel names and mentions in mrkdwn text.
+	Verbatim bool `json:"verbatim,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which TextObject doesn't model.
+	// They're included when the text object is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t TextObject) MarshalJSON() ([]byte, error) {
+	type alias TextObject
+	return marshalExtra(alias(t), t.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *TextObject) UnmarshalJSON(data []byte) error {
+	type alias TextObject
+	extra, err := unmarshalExtra(data, (*alias)(t))
+	if err != nil {
+		return err
+	}
+	t.Extra = extra
+
+	return nil
+}
+
+// NewPlainText returns a new plain text TextObject.
+func NewPlainText(text string) *TextObject {
+	return &TextObject{Type: PlainTextType, Text: text}
+}
+
+// NewMarkdownText returns a new mrkdwn formatted TextObject.
+func NewMarkdownText(text string) *TextObject {
+	return &TextObject{Type: MarkdownType, Text: text}
+}
+
+// contextElement marks TextObject as a ContextElement.
+func (TextObject) contextElement() {}
+
+// Option is a Block Kit option composition object used by selects, overflow menus, checkboxes and radio buttons.
+// See: https://api.slack.com/reference/block-kit/composition-objects#option
+type Option struct {
+	// Text is the text shown for the option.
+	Text *TextObject `json:"text"`
+
+	// Value is the value sent in the interaction payload when the option is chosen.
+	Value string `json:"value"`
+
+	// Description is optional text shown below the Text.
+	Description *TextObject `json:"description,omitempty"`
+
+	// URL is the URL to load in the user's browser when the option is clicked.
+	// Only valid for options in overflow menus.
+	URL string `json:"url,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which Option doesn't model.
+	// They're included when the option is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o Option) MarshalJSON() ([]byte, error) {
+	type alias Option
+	return marshalExtra(alias(o), o.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *Option) UnmarshalJSON(data []byte) error {
+	type alias Option
+	extra, err := unmarshalExtra(data, (*alias)(o))
+	if err != nil {
+		return err
+	}
+	o.Extra = extra
+
+	return nil
+}
+
+// NewOption returns a new Option with plain text and value.
+func NewOption(text, value string) *Option {
+	return &Option{Text: NewPlainText(text), Value: value}
+}
+
+// OptionGroup is a Block Kit option group composition object used to group options in selects.
+// See: https://api.slack.com/reference/block-kit/composition-objects#option_group
+type OptionGroup struct {
+	// Label is the plain text label shown above the group.
+	Label *TextObject `json:"label"`
+
+	// Options are the options in the group.
+	Options []*Option `json:"options"`
+
+	// Extra contains the fields of the decoded JSON which OptionGroup doesn't model.
+	// They're included when the option group is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o OptionGroup) MarshalJSON() ([]byte, error) {
+	type alias OptionGroup
+	return marshalExtra(alias(o), o.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *OptionGroup) UnmarshalJSON(data []byte) error {
+	type alias OptionGroup
+	extra, err := unmarshalExtra(data, (*alias)(o))
+	if err != nil {
+		return err
+	}
+	o.Extra = extra
+
+	return nil
+}
+
+// NewOptionGroup returns a new OptionGroup with a plain text label and options.
+func NewOptionGroup(label string, options ...*Option) *OptionGroup {
+	return &OptionGroup{Label: NewPlainText(label), Options: options}
+}
+
+const (
+	// StylePrimary is the style used for affirmation buttons and dialogs.
+	StylePrimary = "primary"
+
+	// StyleDanger is the style used for destructive buttons and dialogs.
+	StyleDanger = "danger"
+)
+
+// ConfirmationDialog is a Block Kit confirmation dialog composition object
+// which asks the user to confirm an interactive element's action.
+// See: https://api.slack.com/reference/block-kit/composition-objects#confirm
+type ConfirmationDialog struct {
+	// Title is the plain text title of the dialog.
+	Title *TextObject `json:"title"`
+
+	// Text is the explanatory text of the dialog.
+	Text *TextObject `json:"text"`
+
+	// Confirm is the plain text of the button which confirms the action.
+	Confirm *TextObject `json:"confirm"`
+
+	// Deny is the plain text of the button which cancels the action.
+	Deny *TextObject `json:"deny"`
+
+	// Style is the color scheme of the confirm button, StylePrimary or StyleDanger.
+	Style string `json:"style,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which ConfirmationDialog doesn't model.
+	// They're included when the dialog is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c ConfirmationDialog) MarshalJSON() ([]byte, error) {
+	type alias ConfirmationDialog
+	return marshalExtra(alias(c), c.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *ConfirmationDialog) UnmarshalJSON(data []byte) error {
+	type alias ConfirmationDialog
+	extra, err := unmarshalExtra(data, (*alias)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra = extra
+
+	return nil
+}
+
+// NewConfirmationDialog returns a new ConfirmationDialog with plain text title, text, confirm and deny.
+func NewConfirmationDialog(title, text, confirm, deny string) *ConfirmationDialog {
+	return &ConfirmationDialog{
+		Title:   NewPlainText(title),
+		Text:    NewPlainText(text),
+		Confirm: NewPlainText(confirm),
+		Deny:    NewPlainText(deny),
+	}
+}
+
+// Filter is a Block Kit conversation filter composition object which
+// limits the conversations shown in conversation lists.
+// See: https://api.slack.com/reference/block-kit/composition-objects#filter_conversations
+type Filter struct {
+	// Include limits the conversation types, any of "im", "mpim", "private" and "public".
+	Include []string `json:"include,omitempty"`
+
+	// ExcludeExternalSharedChannels excludes external shared channels if true.
+	ExcludeExternalSharedChannels bool `json:"exclude_external_shared_channels,omitempty"`
+
+	// ExcludeBotUsers excludes bot users if true.
+	ExcludeBotUsers bool `json:"exclude_bot_users,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which Filter doesn't model.
+	// They're included when the filter is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f Filter) MarshalJSON() ([]byte, error) {
+	type alias Filter
+	return marshalExtra(alias(f), f.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (f *Filter) UnmarshalJSON(data []byte) error {
+	type alias Filter
+	extra, err := unmarshalExtra(data, (*alias)(f))
+	if err != nil {
+		return err
+	}
+	f.Extra = extra
+
+	return nil
+}
+
+// DispatchActionConfig determines when a plain text input element sends a block_actions payload.
+// See: https://api.slack.com/reference/block-kit/composition-objects#dispatch_action_config
+type DispatchActionConfig struct {
+	// TriggerActionsOn is any of "on_enter_pressed" and "on_character_entered".
+	TriggerActionsOn []string `json:"trigger_actions_on,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which DispatchActionConfig doesn't model.
+	// They're included when the config is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d DispatchActionConfig) MarshalJSON() ([]byte, error) {
+	type alias DispatchActionConfig
+	return marshalExtra(alias(d), d.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *DispatchActionConfig) UnmarshalJSON(data []byte) error {
+	type alias DispatchActionConfig
+	extra, err := unmarshalExtra(data, (*alias)(d))
+	if err != nil {
+		return err
+	}
+	d.Extra = extra
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package chat
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTextObject(t *testing.T) {
+	b, err := json.Marshal(NewPlainText("hello"))
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, `{"type":"plain_text","text":"hello"}`, string(b))
+	}
+
+	txt := NewMarkdownText("*hello*")
+	txt.Verbatim = true
+	b, err = json.Marshal(txt)
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, `{"type":"mrkdwn","text":"*hello*","verbatim":true}`, string(b))
+	}
+}
+
+func TestOption(t *testing.T) {
+	o := NewOption("One", "1")
+	o.Description = NewPlainText("the first")
+	b, err := json.Marshal(o)
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, `{
+			"text":{"type":"plain_text","text":"One"},
+			"value":"1",
+			"description":{"type":"plain_text","text":"the first"}
+		}`, string(b))
+	}
+}
+
+func TestOptionGroup(t *testing.T) {
+	g := NewOptionGroup("Numbers", NewOption("One", "1"), NewOption("Two", "2"))
+	b, err := json.Marshal(g)
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, `{
+			"label":{"type":"plain_text","text":"Numbers"},
+			"options":[
+				{"text":{"type":"plain_text","text":"One"},"value":"1"},
+				{"text":{"type":"plain_text","text":"Two"},"value":"2"}
+			]
+		}`, string(b))
+	}
+}
+
+func TestConfirmationDialog(t *testing.T) {
+	d := NewConfirmationDialog("Sure?", "Really silence?", "Yes", "No")
+	d.Style = StyleDanger
+	b, err := json.Marshal(d)
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, `{
+			"title":{"type":"plain_text","text":"Sure?"},
+			"text":{"type":"plain_text","text":"Really silence?"},
+			"confirm":{"type":"plain_text","text":"Yes"},
+			"deny":{"type":"plain_text","text":"No"},
+			"style":"danger"
+		}`, string(b))
+	}
+}
+
+func TestFilter(t *testing.T) {
+	f := &Filter{Include: []string{"public", "private"}, ExcludeBotUsers: true}
+	b, err := json.Marshal(f)
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, `{"include":["public","private"],"exclude_bot_users":true}`, string(b))
+	}
+}
@@ -0,0 +1,62 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/multiplay/go-slack"
+)
+
+const (
+	// DeleteEndpoint is the slack URL endpoint for chat delete.
+	DeleteEndpoint = "https://slack.com/api/chat.delete"
+)
+
+// Delete is a request to delete a message.
+// Deleting messages requires a token based client such as the api client.
+//
+// See: https://api.slack.com/methods/chat.delete
+type Delete struct {
+	// Channel is the ID of the channel containing the message to delete.
+	Channel string `json:"channel"`
+
+	// Timestamp is the timestamp (ts) of the message to delete.
+	Timestamp string `json:"ts"`
+
+	// AsUser pass true to delete the message as the authed user.
+	AsUser bool `json:"as_user,omitempty"`
+}
+
+// Send sends the delete request to slack using the client c.
+func (d *Delete) Send(c slack.Client) (*DeleteResponse, error) {
+	return d.SendContext(context.Background(), c)
+}
+
+// SendContext sends the delete request to slack using the client c and ctx
+// to control the lifetime of the request.
+func (d *Delete) SendContext(ctx context.Context, c slack.Client) (*DeleteResponse, error) {
+	resp := &DeleteResponse{}
+	if err := slack.NewContextClient(c).SendContext(ctx, DeleteEndpoint, d, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// DeleteResponse the response returned from the delete call.
+type DeleteResponse struct {
+	slack.Response
+	Channel   string `json:"channel,omitempty"`
+	Timestamp string `json:"ts,omitempty"`
+}
+
+// Delete deletes the message r was returned for using the client c.
+func (r *MessageResponse) Delete(c slack.Client) (*DeleteResponse, error) {
+	return r.DeleteContext(context.Background(), c)
+}
+
+// DeleteContext deletes the message r was returned for using the client c
+// and ctx to control the lifetime of the request.
+func (r *MessageResponse) DeleteContext(ctx context.Context, c slack.Client) (*DeleteResponse, error) {
+	d := &Delete{Channel: r.Channel, Timestamp: r.Timestamp}
+	return d.SendContext(ctx, c)
+}
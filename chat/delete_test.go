@@ -0,0 +1,46 @@
+package chat
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiplay/go-slack"
+	"github.com/multiplay/go-slack/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMessageResponseDelete(t *testing.T) {
+	c := test.NewAPI()
+	resp, err := (&Message{Channel: "C123", Text: "cleanup"}).Send(c)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	dresp, err := resp.Delete(c)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.True(t, dresp.OK)
+	assert.Equal(t, "C123", dresp.Channel)
+	assert.Equal(t, resp.Timestamp, dresp.Timestamp)
+
+	r, ok := test.Server().LastRequest()
+	if assert.True(t, ok) {
+		assert.Equal(t, "chat.delete", r.Method)
+		assert.Equal(t, map[string]interface{}{"channel": "C123", "ts": resp.Timestamp}, r.Values())
+	}
+
+	_, ok = test.Server().Message(resp.Channel, resp.Timestamp)
+	assert.False(t, ok)
+
+	// Deleting again fails as the message no longer exists.
+	_, err = resp.Delete(c)
+	assert.True(t, errors.Is(err, slack.ErrMessageNotFound))
+}
+
+func TestDeleteSendError(t *testing.T) {
+	d := &Delete{Timestamp: "1.000000"}
+	_, err := d.Send(test.NewAPI())
+	assert.True(t, errors.Is(err, slack.ErrChannelNotFound))
+}
@@ -0,0 +1,187 @@
+package chat
+
+import (
+	"encoding/json"
+)
+
+const (
+	// ElementTypeImage is the type of an ImageElement.
+	ElementTypeImage = "image"
+
+	// ElementTypeButton is the type of a ButtonElement.
+	ElementTypeButton = "button"
+
+	// ElementTypeStaticSelect is the type of a StaticSelectElement.
+	ElementTypeStaticSelect = "static_select"
+
+	// ElementTypeExternalSelect is the type of an ExternalSelectElement.
+	ElementTypeExternalSelect = "external_select"
+
+	// ElementTypeUsersSelect is the type of a UsersSelectElement.
+	ElementTypeUsersSelect = "users_select"
+
+	// ElementTypeConversationsSelect is the type of a ConversationsSelectElement.
+	ElementTypeConversationsSelect = "conversations_select"
+
+	// ElementTypeChannelsSelect is the type of a ChannelsSelectElement.
+	ElementTypeChannelsSelect = "channels_select"
+
+	// ElementTypeMultiStaticSelect is the type of a MultiStaticSelectElement.
+	ElementTypeMultiStaticSelect = "multi_static_select"
+
+	// ElementTypeMultiExternalSelect is the type of a MultiExternalSelectElement.
+	ElementTypeMultiExternalSelect = "multi_external_select"
+
+	// ElementTypeMultiUsersSelect is the type of a MultiUsersSelectElement.
+	ElementTypeMultiUsersSelect = "multi_users_select"
+
+	// ElementTypeMultiConversationsSelect is the type of a MultiConversationsSelectElement.
+	ElementTypeMultiConversationsSelect = "multi_conversations_select"
+
+	// ElementTypeMultiChannelsSelect is the type of a MultiChannelsSelectElement.
+	ElementTypeMultiChannelsSelect = "multi_channels_select"
+
+	// ElementTypeOverflow is the type of an OverflowElement.
+	ElementTypeOverflow = "overflow"
+
+	// ElementTypeDatePicker is the type of a DatePickerElement.
+	ElementTypeDatePicker = "datepicker"
+
+	// ElementTypeTimePicker is the type of a TimePickerElement.
+	ElementTypeTimePicker = "timepicker"
+
+	// ElementTypeDateTimePicker is the type of a DateTimePickerElement.
+	ElementTypeDateTimePicker = "datetimepicker"
+
+	// ElementTypeCheckboxes is the type of a CheckboxesElement.
+	ElementTypeCheckboxes = "checkboxes"
+
+	// ElementTypeRadioButtons is the type of a RadioButtonsElement.
+	ElementTypeRadioButtons = "radio_buttons"
+
+	// ElementTypePlainTextInput is the type of a PlainTextInputElement.
+	ElementTypePlainTextInput = "plain_text_input"
+
+	// ElementTypeEmailInput is the type of an EmailInputElement.
+	ElementTypeEmailInput = "email_text_input"
+
+	// ElementTypeURLInput is the type of a URLInputElement.
+	ElementTypeURLInput = "url_text_input"
+
+	// ElementTypeNumberInput is the type of a NumberInputElement.
+	ElementTypeNumberInput = "number_input"
+)
+
+// elementTypes maps element types to a function which creates an element of that type.
+var elementTypes = map[string]func() Element{
+	ElementTypeImage:                    func() Element { return &ImageElement{} },
+	ElementTypeButton:                   func() Element { return &ButtonElement{} },
+	ElementTypeStaticSelect:             func() Element { return &StaticSelectElement{} },
+	ElementTypeExternalSelect:           func() Element { return &ExternalSelectElement{} },
+	ElementTypeUsersSelect:              func() Element { return &UsersSelectElement{} },
+	ElementTypeConversationsSelect:      func() Element { return &ConversationsSelectElement{} },
+	ElementTypeChannelsSelect:           func() Element { return &ChannelsSelectElement{} },
+	ElementTypeMultiStaticSelect:        func() Element { return &MultiStaticSelectElement{} },
+	ElementTypeMultiExternalSelect:      func() Element { return &MultiExternalSelectElement{} },
+	ElementTypeMultiUsersSelect:         func() Element { return &MultiUsersSelectElement{} },
+	ElementTypeMultiConversationsSelect: func() Element { return &MultiConversationsSelectElement{} },
+	ElementTypeMultiChannelsSelect:      func() Element { return &MultiChannelsSelectElement{} },
+	ElementTypeOverflow:                 func() Element { return &OverflowElement{} },
+	ElementTypeDatePicker:               func() Element { return &DatePickerElement{} },
+	ElementTypeTimePicker:               func() Element { return &TimePickerElement{} },
+	ElementTypeDateTimePicker:           func() Element { return &DateTimePickerElement{} },
+	ElementTypeCheckboxes:               func() Element { return &CheckboxesElement{} },
+	ElementTypeRadioButtons:             func() Element { return &RadioButtonsElement{} },
+	ElementTypePlainTextInput:           func() Element { return &PlainTextInputElement{} },
+	ElementTypeEmailInput:               func() Element { return &EmailInputElement{} },
+	ElementTypeURLInput:                 func() Element { return &URLInputElement{} },
+	ElementTypeNumberInput:              func() Element { return &NumberInputElement{} },
+}
+
+// contextElementTypes maps the types of context block elements to a function which creates an element of that type.
+var contextElementTypes = map[string]func() ContextElement{
+	PlainTextType:    func() ContextElement { return &TextObject{} },
+	MarkdownType:     func() ContextElement { return &TextObject{} },
+	ElementTypeImage: func() ContextElement { return &ImageElement{} },
+}
+
+// Element is a Block Kit block element.
+// The JSON type field of an element is determined by its Go type.
+// See: https://api.slack.com/reference/block-kit/block-elements
+type Element interface {
+	// ElementType returns the Block Kit type of the element e.g. image.
+	ElementType() string
+}
+
+// Elements is a list of elements which decodes each element from JSON according to its type.
+type Elements []Element
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *Elements) UnmarshalJSON(data []byte) error {
+	s, err := decodeTypedSlice(data, elementTypes, "element")
+	if err != nil {
+		return err
+	}
+	*e = s
+
+	return nil
+}
+
+// ContextElement is an element of a ContextBlock, either a *TextObject or an *ImageElement.
+type ContextElement interface {
+	contextElement()
+}
+
+// ContextElements is a list of context elements which decodes each element from JSON according to its type.
+type ContextElements []ContextElement
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *ContextElements) UnmarshalJSON(data []byte) error {
+	s, err := decodeTypedSlice(data, contextElementTypes, "context element")
+	if err != nil {
+		return err
+	}
+	*e = s
+
+	return nil
+}
+
+// ImageElement displays an image as part of a larger block.
+// See: https://api.slack.com/reference/block-kit/block-elements#image
+type ImageElement struct {
+	// ImageURL is the URL of the image.
+	ImageURL string `json:"image_url"`
+
+	// AltText is a plain text summary of the image.
+	AltText string `json:"alt_text"`
+
+	// Extra contains the fields of the decoded JSON which ImageElement doesn't model.
+	// They're included when the element is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// ElementType implements Element.
+func (ImageElement) ElementType() string {
+	return ElementTypeImage
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e ImageElement) MarshalJSON() ([]byte, error) {
+	type alias ImageElement
+	return marshalTyped(e.ElementType(), alias(e), e.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *ImageElement) UnmarshalJSON(data []byte) error {
+	type alias ImageElement
+	extra, err := unmarshalTyped(data, (*alias)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+
+	return nil
+}
+
+// contextElement marks ImageElement as a ContextElement.
+func (ImageElement) contextElement() {}
@@ -0,0 +1,47 @@
+package chat
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageElement(t *testing.T) {
+	e := &ImageElement{ImageURL: "https://example.com/a.png", AltText: "a"}
+	b, err := json.Marshal(e)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.JSONEq(t, `{"type":"image","image_url":"https://example.com/a.png","alt_text":"a"}`, string(b))
+
+	var es Elements
+	if assert.NoError(t, json.Unmarshal([]byte("["+string(b)+"]"), &es)) {
+		assert.Equal(t, Elements{e}, es)
+	}
+}
+
+func TestElementsUnmarshalError(t *testing.T) {
+	var es Elements
+	assert.Error(t, json.Unmarshal([]byte(`[1]`), &es))
+}
+
+func TestContextElementsUnmarshal(t *testing.T) {
+	var es ContextElements
+	err := json.Unmarshal([]byte(`[
+		{"type":"mrkdwn","text":"*hi*"},
+		{"type":"plain_text","text":"hi","emoji":true},
+		{"type":"image","image_url":"https://example.com/a.png","alt_text":"a"}
+	]`), &es)
+	if !assert.NoError(t, err) {
+		return
+	}
+	emoji := true
+	assert.Equal(t, ContextElements{
+		NewMarkdownText("*hi*"),
+		&TextObject{Type: PlainTextType, Text: "hi", Emoji: &emoji},
+		&ImageElement{ImageURL: "https://example.com/a.png", AltText: "a"},
+	}, es)
+
+	assert.Error(t, json.Unmarshal([]byte(`[1]`), &es))
+}
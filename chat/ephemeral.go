@@ -0,0 +1,87 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/multiplay/go-slack"
+)
+
+const (
+	// PostEphemeralEndpoint is the slack URL endpoint for chat post ephemeral.
+	PostEphemeralEndpoint = "https://slack.com/api/chat.postEphemeral"
+)
+
+// Ephemeral is a message which is only visible to User in Channel.
+// Ephemeral messages aren't persisted so can't be updated or deleted.
+//
+// Posting ephemeral messages requires a token based client such as the api client.
+//
+// See: https://api.slack.com/methods/chat.postEphemeral
+type Ephemeral struct {
+	Message
+
+	// User is the ID of the user who will see the message.
+	// The user must be a member of Channel.
+	User string
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e Ephemeral) MarshalJSON() ([]byte, error) {
+	return marshalWith(e.Message, map[string]interface{}{"user": e.User})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *Ephemeral) UnmarshalJSON(data []byte) error {
+	return unmarshalWith(data, &e.Message, map[string]interface{}{"user": &e.User})
+}
+
+// Send sends the ephemeral message to slack using the client c.
+func (e *Ephemeral) Send(c slack.Client) (*EphemeralResponse, error) {
+	return e.SendContext(context.Background(), c)
+}
+
+// SendContext sends the ephemeral message to slack using the client c and
+// ctx to control the lifetime of the request.
+func (e *Ephemeral) SendContext(ctx context.Context, c slack.Client) (*EphemeralResponse, error) {
+	resp := &EphemeralResponse{}
+	if err := slack.NewContextClient(c).SendContext(ctx, PostEphemeralEndpoint, e, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// SendFit sends the ephemeral message to slack using the client c, first
+// fitting it within the limits of slack using Fit with cfg.
+// Each message is only visible to User. cfg.Thread is ignored as ephemeral
+// messages can't be replied to.
+// It returns the responses for the messages which were sent successfully.
+func (e *Ephemeral) SendFit(c slack.Client, cfg FitConfig) ([]*EphemeralResponse, error) {
+	return e.SendFitContext(context.Background(), c, cfg)
+}
+
+// SendFitContext sends the ephemeral message to slack using the client c and
+// ctx to control the lifetime of the requests, first fitting it within the
+// limits of slack using Fit with cfg.
+// Each message is only visible to User. cfg.Thread is ignored as ephemeral
+// messages can't be replied to.
+// It returns the responses for the messages which were sent successfully.
+func (e *Ephemeral) SendFitContext(ctx context.Context, c slack.Client, cfg FitConfig) ([]*EphemeralResponse, error) {
+	msgs := e.Message.Fit(cfg)
+	resps := make([]*EphemeralResponse, 0, len(msgs))
+	for _, m := range msgs {
+		resp, err := (&Ephemeral{Message: *m, User: e.User}).SendContext(ctx, c)
+		if err != nil {
+			return resps, err
+		}
+		resps = append(resps, resp)
+	}
+
+	return resps, nil
+}
+
+// EphemeralResponse the response returned from the post ephemeral call.
+type EphemeralResponse struct {
+	slack.Response
+	MessageTimestamp string `json:"message_ts,omitempty"`
+}
@@ -0,0 +1,76 @@
+package chat
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/multiplay/go-slack"
+	"github.com/multiplay/go-slack/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEphemeralJSON(t *testing.T) {
+	e := &Ephemeral{Message: Message{Channel: "C123", Text: "hint"}, User: "U123"}
+	b, err := json.Marshal(e)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.JSONEq(t, `{"channel":"C123","text":"hint","user":"U123"}`, string(b))
+
+	e2 := &Ephemeral{}
+	if assert.NoError(t, json.Unmarshal(b, e2)) {
+		assert.Equal(t, e, e2)
+	}
+}
+
+func TestEphemeralSend(t *testing.T) {
+	e := &Ephemeral{Message: Message{Channel: "C123", Text: "hint"}, User: "U123"}
+	resp, err := e.Send(test.NewAPI())
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.True(t, resp.OK)
+	assert.NotEmpty(t, resp.MessageTimestamp)
+
+	r, ok := test.Server().LastRequest()
+	if assert.True(t, ok) {
+		assert.Equal(t, "chat.postEphemeral", r.Method)
+		assert.Equal(t, "U123", r.Values()["user"])
+	}
+}
+
+func TestEphemeralSendError(t *testing.T) {
+	e := &Ephemeral{Message: Message{Channel: "C123", Text: "hint"}}
+	_, err := e.Send(test.NewAPI())
+	assert.True(t, errors.Is(err, slack.ErrUserNotFound))
+}
+
+func TestEphemeralSendFit(t *testing.T) {
+	test.Server().Reset()
+	e := &Ephemeral{Message: Message{Channel: "C123", Text: strings.Repeat("a", MaxTextLen+1)}, User: "U123"}
+	resps, err := e.SendFit(test.NewAPI(), FitConfig{Split: true, Thread: true})
+	if !assert.NoError(t, err) || !assert.Len(t, resps, 2) {
+		return
+	}
+
+	reqs := test.Server().Requests()
+	if assert.Len(t, reqs, 2) {
+		for _, r := range reqs {
+			v := r.Values()
+			assert.Equal(t, "chat.postEphemeral", r.Method)
+			assert.Equal(t, "U123", v["user"])
+			assert.NotContains(t, v, "thread_ts")
+		}
+		assert.Equal(t, "a", reqs[1].Values()["text"])
+	}
+}
+
+func TestEphemeralSendFitError(t *testing.T) {
+	e := &Ephemeral{Message: Message{Channel: "C123", Text: strings.Repeat("a", MaxTextLen+1)}}
+	resps, err := e.SendFit(test.NewAPI(), FitConfig{Split: true})
+	assert.Empty(t, resps)
+	assert.True(t, errors.Is(err, slack.ErrUserNotFound))
+}
@@ -1,5 +1,9 @@
 package chat
 
+import (
+	"encoding/json"
+)
+
 var (
 	// ShortFieldLen is the length of a field value which is to be deemed short.
 	ShortFieldLen = 20
@@ -12,12 +16,34 @@ type Field struct {
 	Title string `json:"title"`
 
 	// Value is the text value of the field.
-	// It may contain standard message markup and must be escaped as normal.
+	// It may contain standard message markup and must be escaped as normal, see mrkdwn.Escape.
 	// May be multi-line.
 	Value string `json:"value"`
 
 	// Short is an optional flag indicating whether the value is short enough to be displayed side-by-side with other values.
 	Short bool `json:"short"`
+
+	// Extra contains the fields of the decoded JSON which Field doesn't model.
+	// They're included when the field is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f Field) MarshalJSON() ([]byte, error) {
+	type alias Field
+	return marshalExtra(alias(f), f.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (f *Field) UnmarshalJSON(data []byte) error {
+	type alias Field
+	extra, err := unmarshalExtra(data, (*alias)(f))
+	if err != nil {
+		return err
+	}
+	f.Extra = extra
+
+	return nil
 }
 
 // NewField returns a fully initialised field with Short set to true if the length of value is less than ShortFieldLen.
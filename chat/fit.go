@@ -0,0 +1,289 @@
+package chat
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/multiplay/go-slack"
+)
+
+var (
+	// DefaultEllipsis is the default marker appended to truncated text.
+	DefaultEllipsis = "…"
+
+	// MaxFieldValueLen is the length at which Fit truncates attachment field values.
+	MaxFieldValueLen = 2000
+)
+
+// FitConfig is the configuration used by Fit.
+type FitConfig struct {
+	// Ellipsis is the marker appended to truncated text.
+	Ellipsis string
+
+	// Split if true splits text, blocks and attachments which exceed the
+	// limits across multiple messages instead of truncating them.
+	// Attachment text and field values which are too long are continued in
+	// attachments posted as the messages which follow.
+	//
+	// If false, blocks beyond MaxBlocks and attachments beyond MaxAttachments
+	// are dropped without a marker, as there's no valid way to indicate it
+	// in the message. Use Validate on the original message to detect this.
+	// Attachment blocks beyond MaxBlocks are dropped either way.
+	Split bool
+
+	// Thread if true posts the messages after the first as replies in the
+	// thread of the first, instead of to the channel.
+	// Requires a client whose responses include the message timestamp such
+	// as the api client, otherwise the messages are posted to the channel.
+	Thread bool
+}
+
+// SetFitConfigDefaults sets defaults on the configuration if needed to ensure the cfg is valid.
+func SetFitConfigDefaults(cfg *FitConfig) {
+	if cfg.Ellipsis == "" {
+		cfg.Ellipsis = DefaultEllipsis
+	}
+}
+
+// Truncate returns s truncated to at most max runes with ellipsis appended
+// if s is longer than max. It always truncates at a rune boundary.
+func Truncate(s string, max int, ellipsis string) string {
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+
+	n := max - utf8.RuneCountInString(ellipsis)
+	if n < 0 {
+		return runes(s, max)
+	}
+
+	return runes(s, n) + ellipsis
+}
+
+// runes returns the first n runes of s.
+func runes(s string, n int) string {
+	for i := range s {
+		if n == 0 {
+			return s[:i]
+		}
+		n--
+	}
+
+	return s
+}
+
+// splitText splits s into chunks of at most max runes, preferring to split after a newline.
+func splitText(s string, max int) []string {
+	var chunks []string
+	for utf8.RuneCountInString(s) > max {
+		c := runes(s, max)
+		if i := strings.LastIndexByte(c, '\n'); i > 0 {
+			c = c[:i+1]
+		}
+		chunks = append(chunks, c)
+		s = s[len(c):]
+	}
+
+	return append(chunks, s)
+}
+
+// split splits s into chunks of at most max entries.
+func split[T any](s []T, max int) [][]T {
+	var chunks [][]T
+	for len(s) > max {
+		chunks = append(chunks, s[:max])
+		s = s[max:]
+	}
+
+	return append(chunks, s)
+}
+
+// Fit returns m as one or more messages which fit within the limits of slack.
+// Block text which is too long is truncated. Message text, blocks,
+// attachments, attachment text and field values which exceed the limits are
+// truncated, or if cfg.Split is true split across multiple messages.
+// Blocks and attachments which are truncated are dropped, see FitConfig.Split.
+// Attachment text and field values are split into continuation attachments,
+// with the same color, each sent in its own message straight after the
+// message containing the attachment they continue.
+// m is not modified.
+func (m *Message) Fit(cfg FitConfig) []*Message {
+	SetFitConfigDefaults(&cfg)
+
+	texts := splitText(m.Text, MaxTextLen)
+	blocks := split(fitBlocks(m.Blocks, cfg), MaxBlocks)
+	atts := split(m.Attachments, MaxAttachments)
+
+	n := 1
+	if cfg.Split {
+		n = len(texts)
+		if len(blocks) > n {
+			n = len(blocks)
+		}
+		if len(atts) > n {
+			n = len(atts)
+		}
+	}
+
+	msgs := make([]*Message, 0, n)
+	for i := 0; i < n; i++ {
+		pm := *m
+		pm.Text, pm.Blocks, pm.Attachments = "", nil, nil
+		if i < len(texts) {
+			pm.Text = texts[i]
+		}
+		if i < len(blocks) {
+			pm.Blocks = blocks[i]
+		}
+
+		var conts []*Attachment
+		if i < len(atts) {
+			for _, a := range atts[i] {
+				fa, cont := a.fit(cfg)
+				pm.Attachments = append(pm.Attachments, fa)
+				conts = append(conts, cont...)
+			}
+		}
+		msgs = append(msgs, &pm)
+
+		for _, a := range conts {
+			cm := *m
+			cm.Text, cm.Blocks, cm.Attachments = "", nil, []*Attachment{a}
+			msgs = append(msgs, &cm)
+		}
+	}
+
+	if !cfg.Split {
+		msgs[0].Text = Truncate(m.Text, MaxTextLen, cfg.Ellipsis)
+	}
+
+	return msgs
+}
+
+// fit returns a copy of a with text which is too long truncated.
+// If cfg.Split is true text and field values which are too long are instead
+// split, with the remainder returned as continuation attachments.
+func (a *Attachment) fit(cfg FitConfig) (*Attachment, []*Attachment) {
+	if a == nil {
+		return nil, nil
+	}
+
+	var conts []*Attachment
+	fa := *a
+	fa.Fallback = Truncate(a.Fallback, MaxTextLen, cfg.Ellipsis)
+	fa.PreText = Truncate(a.PreText, MaxTextLen, cfg.Ellipsis)
+	if cfg.Split {
+		texts := splitText(a.Text, MaxTextLen)
+		fa.Text = texts[0]
+		for _, t := range texts[1:] {
+			ca := a.continuation()
+			ca.Text = t
+			conts = append(conts, ca)
+		}
+	} else {
+		fa.Text = Truncate(a.Text, MaxTextLen, cfg.Ellipsis)
+	}
+	fa.Footer = Truncate(a.Footer, MaxFooterLen, cfg.Ellipsis)
+	fa.Blocks = fitBlocks(a.Blocks, cfg)
+	if len(fa.Blocks) > MaxBlocks {
+		fa.Blocks = fa.Blocks[:MaxBlocks]
+	}
+
+	fa.Fields = nil
+	for _, f := range a.Fields {
+		if f != nil {
+			ff := *f
+			if cfg.Split {
+				values := splitText(f.Value, MaxFieldValueLen)
+				ff.Value = values[0]
+				for _, v := range values[1:] {
+					ca := a.continuation()
+					ca.Fields = []*Field{{Title: f.Title, Value: v}}
+					conts = append(conts, ca)
+				}
+			} else {
+				ff.Value = Truncate(f.Value, MaxFieldValueLen, cfg.Ellipsis)
+			}
+			f = &ff
+		}
+		fa.Fields = append(fa.Fields, f)
+	}
+
+	return &fa, conts
+}
+
+// continuation returns a new attachment which continues a, sharing its
+// color and mrkdwn formatting.
+func (a *Attachment) continuation() *Attachment {
+	return &Attachment{Color: a.Color, MarkdownIn: a.MarkdownIn}
+}
+
+// fitBlocks returns a copy of blocks with section and header text which is too long truncated.
+func fitBlocks(blocks Blocks, cfg FitConfig) Blocks {
+	if blocks == nil {
+		return nil
+	}
+
+	fb := make(Blocks, len(blocks))
+	for i, b := range blocks {
+		switch b := pointer(b).(type) {
+		case *SectionBlock:
+			sb := *b
+			sb.Text = fitText(b.Text, MaxSectionTextLen, cfg)
+			sb.Fields = make([]*TextObject, len(b.Fields))
+			for j, f := range b.Fields {
+				sb.Fields[j] = fitText(f, MaxSectionFieldLen, cfg)
+			}
+			fb[i] = &sb
+		case *HeaderBlock:
+			hb := *b
+			hb.Text = fitText(b.Text, MaxHeaderTextLen, cfg)
+			fb[i] = &hb
+		default:
+			fb[i] = blocks[i]
+		}
+	}
+
+	return fb
+}
+
+// fitText returns a copy of t with its text truncated to max.
+func fitText(t *TextObject, max int, cfg FitConfig) *TextObject {
+	if t == nil {
+		return nil
+	}
+
+	ft := *t
+	ft.Text = Truncate(t.Text, max, cfg.Ellipsis)
+
+	return &ft
+}
+
+// SendFit sends m to slack using the client c, first fitting it within the
+// limits of slack using Fit with cfg.
+// It returns the responses for the messages which were sent successfully.
+func (m *Message) SendFit(c slack.Client, cfg FitConfig) ([]*MessageResponse, error) {
+	return m.SendFitContext(context.Background(), c, cfg)
+}
+
+// SendFitContext sends m to slack using the client c and ctx to control the
+// lifetime of the requests, first fitting it within the limits of slack using Fit with cfg.
+// It returns the responses for the messages which were sent successfully.
+func (m *Message) SendFitContext(ctx context.Context, c slack.Client, cfg FitConfig) ([]*MessageResponse, error) {
+	msgs := m.Fit(cfg)
+	resps := make([]*MessageResponse, 0, len(msgs))
+	for i, pm := range msgs {
+		if i > 0 && cfg.Thread && pm.ThreadTS == "" && resps[0].Timestamp != "" {
+			pm.ThreadTS = resps[0].Timestamp
+		}
+
+		resp, err := pm.SendContext(ctx, c)
+		if err != nil {
+			return resps, err
+		}
+		resps = append(resps, resp)
+	}
+
+	return resps, nil
+}
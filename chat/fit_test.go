@@ -0,0 +1,225 @@
+package chat
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/multiplay/go-slack"
+	"github.com/multiplay/go-slack/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		s        string
+		max      int
+		ellipsis string
+		expected string
+	}{
+		{"hello", 5, "…", "hello"},
+		{"hello world", 5, "…", "hell…"},
+		{"hello world", 8, "...", "hello..."},
+		{"héllo wörld", 6, "…", "héllo…"},
+		{"日本語テキスト", 4, "…", "日本語…"},
+		{"hello", 2, "...", "he"},
+		{"hello", 0, "", ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.s, func(t *testing.T) {
+			s := Truncate(tc.s, tc.max, tc.ellipsis)
+			assert.Equal(t, tc.expected, s)
+			assert.True(t, utf8.ValidString(s))
+		})
+	}
+}
+
+func TestSplitText(t *testing.T) {
+	assert.Equal(t, []string{""}, splitText("", 5))
+	assert.Equal(t, []string{"hello"}, splitText("hello", 5))
+	assert.Equal(t, []string{"héllo", " wörl", "d"}, splitText("héllo wörld", 5))
+	assert.Equal(t, []string{"ab\n", "cdef\n", "g"}, splitText("ab\ncdef\ng", 5))
+}
+
+func TestFitTruncate(t *testing.T) {
+	m := &Message{Channel: "C123", Text: strings.Repeat("a", MaxTextLen+10)}
+	for i := 0; i < MaxBlocks+1; i++ {
+		m.AddBlock(&SectionBlock{Text: NewMarkdownText(strings.Repeat("b", MaxSectionTextLen+1))})
+	}
+	a := m.NewAttachment()
+	a.Text = strings.Repeat("c", MaxTextLen+1)
+	a.Footer = strings.Repeat("d", MaxFooterLen+1)
+	a.NewField("trace", strings.Repeat("e", MaxFieldValueLen+1))
+
+	msgs := m.Fit(FitConfig{Ellipsis: "[...]"})
+	if !assert.Len(t, msgs, 1) {
+		return
+	}
+
+	fm := msgs[0]
+	assert.NoError(t, fm.Validate())
+	assert.Equal(t, "C123", fm.Channel)
+	assert.Equal(t, MaxTextLen, len(fm.Text))
+	assert.True(t, strings.HasSuffix(fm.Text, "[...]"))
+	assert.Len(t, fm.Blocks, MaxBlocks)
+	assert.Equal(t, MaxSectionTextLen, len(fm.Blocks[0].(*SectionBlock).Text.Text))
+	assert.Equal(t, MaxTextLen, len(fm.Attachments[0].Text))
+	assert.Equal(t, MaxFooterLen, len(fm.Attachments[0].Footer))
+	assert.Equal(t, MaxFieldValueLen, len(fm.Attachments[0].Fields[0].Value))
+
+	// The original message is unchanged.
+	assert.Equal(t, MaxTextLen+10, len(m.Text))
+	assert.Len(t, m.Blocks, MaxBlocks+1)
+	assert.Equal(t, MaxSectionTextLen+1, len(m.Blocks[0].(*SectionBlock).Text.Text))
+	assert.Equal(t, MaxTextLen+1, len(a.Text))
+	assert.Equal(t, MaxFieldValueLen+1, len(a.Fields[0].Value))
+}
+
+func TestFitTruncateDrops(t *testing.T) {
+	m := &Message{Channel: "C123"}
+	for i := 0; i < MaxAttachments+1; i++ {
+		m.NewAttachment().Title = strconv.Itoa(i)
+	}
+	for i := 0; i < MaxBlocks+1; i++ {
+		m.Attachments[0].AddBlock(&DividerBlock{})
+	}
+	assert.Error(t, m.Validate())
+
+	msgs := m.Fit(FitConfig{})
+	if !assert.Len(t, msgs, 1) {
+		return
+	}
+
+	// Attachments and blocks over the limits are dropped without a marker.
+	fm := msgs[0]
+	assert.NoError(t, fm.Validate())
+	if assert.Len(t, fm.Attachments, MaxAttachments) {
+		assert.Equal(t, strconv.Itoa(MaxAttachments-1), fm.Attachments[MaxAttachments-1].Title)
+	}
+	assert.Len(t, fm.Attachments[0].Blocks, MaxBlocks)
+
+	// Attachment blocks are dropped even when splitting.
+	msgs = m.Fit(FitConfig{Split: true})
+	if assert.Len(t, msgs, 2) {
+		assert.Len(t, msgs[0].Attachments[0].Blocks, MaxBlocks)
+		assert.Len(t, msgs[1].Attachments, 1)
+	}
+}
+
+func TestFitSplit(t *testing.T) {
+	m := &Message{Channel: "C123", Text: strings.Repeat("a", MaxTextLen*2+1)}
+	for i := 0; i < MaxBlocks+1; i++ {
+		m.AddBlock(DividerBlock{})
+	}
+	for i := 0; i < MaxAttachments*3+1; i++ {
+		m.NewAttachment().Text = "text"
+	}
+
+	msgs := m.Fit(FitConfig{Split: true})
+	if !assert.Len(t, msgs, 4) {
+		return
+	}
+
+	for i, fm := range msgs {
+		assert.NoError(t, fm.Validate(), "message %d", i)
+		assert.Equal(t, "C123", fm.Channel)
+	}
+	assert.Equal(t, MaxTextLen, len(msgs[0].Text))
+	assert.Equal(t, MaxTextLen, len(msgs[1].Text))
+	assert.Equal(t, "a", msgs[2].Text)
+	assert.Empty(t, msgs[3].Text)
+	assert.Len(t, msgs[0].Blocks, MaxBlocks)
+	assert.Len(t, msgs[1].Blocks, 1)
+	assert.Empty(t, msgs[2].Blocks)
+	assert.Len(t, msgs[0].Attachments, MaxAttachments)
+	assert.Len(t, msgs[3].Attachments, 1)
+
+	msgs = (&Message{Text: "small"}).Fit(FitConfig{Split: true})
+	if assert.Len(t, msgs, 1) {
+		assert.Equal(t, "small", msgs[0].Text)
+	}
+}
+
+func TestSendFit(t *testing.T) {
+	m := &Message{Channel: "C123", Text: strings.Repeat("a", MaxTextLen+1)}
+
+	resps, err := m.SendFit(test.NewAPI(), FitConfig{Split: true, Thread: true})
+	if !assert.NoError(t, err) || !assert.Len(t, resps, 2) {
+		return
+	}
+
+	r, ok := test.Server().LastRequest()
+	if assert.True(t, ok) {
+		v := r.Values()
+		assert.Equal(t, resps[0].Timestamp, v["thread_ts"])
+		assert.Equal(t, "a", v["text"])
+	}
+
+	// Without Thread all messages are posted to the channel.
+	_, err = m.SendFit(test.NewAPI(), FitConfig{Split: true})
+	assert.NoError(t, err)
+	r, ok = test.Server().LastRequest()
+	if assert.True(t, ok) {
+		assert.NotContains(t, r.Values(), "thread_ts")
+	}
+}
+
+func TestSendFitError(t *testing.T) {
+	m := &Message{Text: strings.Repeat("a", MaxTextLen+1)}
+	resps, err := m.SendFit(test.NewAPI(), FitConfig{Split: true})
+	assert.Empty(t, resps)
+	assert.True(t, errors.Is(err, slack.ErrChannelNotFound))
+}
+
+func TestFitSplitAttachment(t *testing.T) {
+	// A single attachment carrying a long stack trace in its text and a field.
+	var trace strings.Builder
+	for i := 0; trace.Len() < MaxTextLen*2; i++ {
+		fmt.Fprintf(&trace, "goroutine %d [running]:\nmain.main()\n\t/src/main.go:%d +0x1d\n", i, i)
+	}
+	m := &Message{Channel: "C123"}
+	a := m.NewAttachment()
+	a.Color = "danger"
+	a.Text = trace.String()
+	a.NewField("stack", strings.Repeat("f", MaxFieldValueLen*2+1))
+	a.NewField("level", "error")
+
+	// The attachment and two continuations of each of its text and stack field.
+	msgs := m.Fit(FitConfig{Split: true})
+	if !assert.Len(t, msgs, 5) {
+		return
+	}
+
+	var text, stack strings.Builder
+	for i, fm := range msgs {
+		assert.NoError(t, fm.Validate(), "message %d", i)
+		assert.Equal(t, "C123", fm.Channel)
+		if !assert.Len(t, fm.Attachments, 1) {
+			return
+		}
+		fa := fm.Attachments[0]
+		assert.Equal(t, "danger", fa.Color)
+		text.WriteString(fa.Text)
+		for _, f := range fa.Fields {
+			if f.Title == "stack" {
+				stack.WriteString(f.Value)
+			}
+		}
+	}
+
+	// Nothing is lost and the attachment text is split after a newline.
+	assert.Equal(t, a.Text, text.String())
+	assert.Equal(t, a.Fields[0].Value, stack.String())
+	assert.True(t, strings.HasSuffix(msgs[0].Attachments[0].Text, "\n"))
+	assert.Len(t, msgs[0].Attachments[0].Fields, 2)
+
+	// Without Split the attachment is truncated as before.
+	msgs = m.Fit(FitConfig{})
+	if assert.Len(t, msgs, 1) {
+		assert.Equal(t, MaxTextLen, utf8.RuneCountInString(msgs[0].Attachments[0].Text))
+	}
+}
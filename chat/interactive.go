@@ -0,0 +1,1045 @@
+package chat
+
+import (
+	"encoding/json"
+)
+
+// ButtonElement is an interactive button which sends a block_actions payload or opens a URL when clicked.
+// See: https://api.slack.com/reference/block-kit/block-elements#button
+type ButtonElement struct {
+	// Text is the plain text of the button.
+	Text *TextObject `json:"text"`
+
+	// ActionID identifies the action in the interaction payload.
+	ActionID string `json:"action_id,omitempty"`
+
+	// URL is an optional URL to load in the user's browser when clicked.
+	URL string `json:"url,omitempty"`
+
+	// Value is sent in the interaction payload when clicked.
+	Value string `json:"value,omitempty"`
+
+	// Style is the color scheme of the button, StylePrimary or StyleDanger.
+	Style string `json:"style,omitempty"`
+
+	// Confirm is an optional dialog shown before the action is performed.
+	Confirm *ConfirmationDialog `json:"confirm,omitempty"`
+
+	// AccessibilityLabel is the label read by screen readers instead of Text.
+	AccessibilityLabel string `json:"accessibility_label,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which ButtonElement doesn't model.
+	// They're included when the element is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// ElementType implements Element.
+func (ButtonElement) ElementType() string {
+	return ElementTypeButton
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e ButtonElement) MarshalJSON() ([]byte, error) {
+	type alias ButtonElement
+	return marshalTyped(e.ElementType(), alias(e), e.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *ButtonElement) UnmarshalJSON(data []byte) error {
+	type alias ButtonElement
+	extra, err := unmarshalTyped(data, (*alias)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+
+	return nil
+}
+
+// NewButton returns a new ButtonElement with actionID, plain text and value.
+func NewButton(actionID, text, value string) *ButtonElement {
+	return &ButtonElement{ActionID: actionID, Text: NewPlainText(text), Value: value}
+}
+
+// StaticSelectElement is a select menu with a static list of options.
+// See: https://api.slack.com/reference/block-kit/block-elements#static_select
+type StaticSelectElement struct {
+	// Placeholder is the plain text shown when nothing is selected.
+	Placeholder *TextObject `json:"placeholder,omitempty"`
+
+	// ActionID identifies the action in the interaction payload.
+	ActionID string `json:"action_id,omitempty"`
+
+	// Options are the options of the menu, either Options or OptionGroups must be set.
+	Options []*Option `json:"options,omitempty"`
+
+	// OptionGroups are the grouped options of the menu.
+	OptionGroups []*OptionGroup `json:"option_groups,omitempty"`
+
+	// InitialOption is the option selected when the menu loads.
+	InitialOption *Option `json:"initial_option,omitempty"`
+
+	// Confirm is an optional dialog shown after an option is selected.
+	Confirm *ConfirmationDialog `json:"confirm,omitempty"`
+
+	// FocusOnLoad if true sets focus on the element when loaded.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which StaticSelectElement doesn't model.
+	// They're included when the element is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// ElementType implements Element.
+func (StaticSelectElement) ElementType() string {
+	return ElementTypeStaticSelect
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e StaticSelectElement) MarshalJSON() ([]byte, error) {
+	type alias StaticSelectElement
+	return marshalTyped(e.ElementType(), alias(e), e.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *StaticSelectElement) UnmarshalJSON(data []byte) error {
+	type alias StaticSelectElement
+	extra, err := unmarshalTyped(data, (*alias)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+
+	return nil
+}
+
+// ExternalSelectElement is a select menu whose options are loaded from an external data source.
+// See: https://api.slack.com/reference/block-kit/block-elements#external_select
+type ExternalSelectElement struct {
+	// Placeholder is the plain text shown when nothing is selected.
+	Placeholder *TextObject `json:"placeholder,omitempty"`
+
+	// ActionID identifies the action in the interaction payload.
+	ActionID string `json:"action_id,omitempty"`
+
+	// InitialOption is the option selected when the menu loads.
+	InitialOption *Option `json:"initial_option,omitempty"`
+
+	// MinQueryLength is the number of characters typed before options are requested.
+	MinQueryLength int `json:"min_query_length,omitempty"`
+
+	// Confirm is an optional dialog shown after an option is selected.
+	Confirm *ConfirmationDialog `json:"confirm,omitempty"`
+
+	// FocusOnLoad if true sets focus on the element when loaded.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which ExternalSelectElement doesn't model.
+	// They're included when the element is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// ElementType implements Element.
+func (ExternalSelectElement) ElementType() string {
+	return ElementTypeExternalSelect
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e ExternalSelectElement) MarshalJSON() ([]byte, error) {
+	type alias ExternalSelectElement
+	return marshalTyped(e.ElementType(), alias(e), e.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *ExternalSelectElement) UnmarshalJSON(data []byte) error {
+	type alias ExternalSelectElement
+	extra, err := unmarshalTyped(data, (*alias)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+
+	return nil
+}
+
+// UsersSelectElement is a select menu listing the users of the workspace.
+// See: https://api.slack.com/reference/block-kit/block-elements#users_select
+type UsersSelectElement struct {
+	// Placeholder is the plain text shown when nothing is selected.
+	Placeholder *TextObject `json:"placeholder,omitempty"`
+
+	// ActionID identifies the action in the interaction payload.
+	ActionID string `json:"action_id,omitempty"`
+
+	// InitialUser is the ID of the user selected when the menu loads.
+	InitialUser string `json:"initial_user,omitempty"`
+
+	// Confirm is an optional dialog shown after a user is selected.
+	Confirm *ConfirmationDialog `json:"confirm,omitempty"`
+
+	// FocusOnLoad if true sets focus on the element when loaded.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which UsersSelectElement doesn't model.
+	// They're included when the element is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// ElementType implements Element.
+func (UsersSelectElement) ElementType() string {
+	return ElementTypeUsersSelect
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e UsersSelectElement) MarshalJSON() ([]byte, error) {
+	type alias UsersSelectElement
+	return marshalTyped(e.ElementType(), alias(e), e.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *UsersSelectElement) UnmarshalJSON(data []byte) error {
+	type alias UsersSelectElement
+	extra, err := unmarshalTyped(data, (*alias)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+
+	return nil
+}
+
+// ConversationsSelectElement is a select menu listing public and private channels, DMs and MPIMs.
+// See: https://api.slack.com/reference/block-kit/block-elements#conversations_select
+type ConversationsSelectElement struct {
+	// Placeholder is the plain text shown when nothing is selected.
+	Placeholder *TextObject `json:"placeholder,omitempty"`
+
+	// ActionID identifies the action in the interaction payload.
+	ActionID string `json:"action_id,omitempty"`
+
+	// InitialConversation is the ID of the conversation selected when the menu loads.
+	InitialConversation string `json:"initial_conversation,omitempty"`
+
+	// DefaultToCurrentConversation if true selects the current conversation when the menu loads.
+	DefaultToCurrentConversation bool `json:"default_to_current_conversation,omitempty"`
+
+	// ResponseURLEnabled if true includes a response_url in view submissions, only valid in modals.
+	ResponseURLEnabled bool `json:"response_url_enabled,omitempty"`
+
+	// Filter limits the conversations listed.
+	Filter *Filter `json:"filter,omitempty"`
+
+	// Confirm is an optional dialog shown after a conversation is selected.
+	Confirm *ConfirmationDialog `json:"confirm,omitempty"`
+
+	// FocusOnLoad if true sets focus on the element when loaded.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which ConversationsSelectElement doesn't model.
+	// They're included when the element is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// ElementType implements Element.
+func (ConversationsSelectElement) ElementType() string {
+	return ElementTypeConversationsSelect
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e ConversationsSelectElement) MarshalJSON() ([]byte, error) {
+	type alias ConversationsSelectElement
+	return marshalTyped(e.ElementType(), alias(e), e.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *ConversationsSelectElement) UnmarshalJSON(data []byte) error {
+	type alias ConversationsSelectElement
+	extra, err := unmarshalTyped(data, (*alias)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+
+	return nil
+}
+
+// ChannelsSelectElement is a select menu listing the public channels of the workspace.
+// See: https://api.slack.com/reference/block-kit/block-elements#channels_select
+type ChannelsSelectElement struct {
+	// Placeholder is the plain text shown when nothing is selected.
+	Placeholder *TextObject `json:"placeholder,omitempty"`
+
+	// ActionID identifies the action in the interaction payload.
+	ActionID string `json:"action_id,omitempty"`
+
+	// InitialChannel is the ID of the channel selected when the menu loads.
+	InitialChannel string `json:"initial_channel,omitempty"`
+
+	// ResponseURLEnabled if true includes a response_url in view submissions, only valid in modals.
+	ResponseURLEnabled bool `json:"response_url_enabled,omitempty"`
+
+	// Confirm is an optional dialog shown after a channel is selected.
+	Confirm *ConfirmationDialog `json:"confirm,omitempty"`
+
+	// FocusOnLoad if true sets focus on the element when loaded.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which ChannelsSelectElement doesn't model.
+	// They're included when the element is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// ElementType implements Element.
+func (ChannelsSelectElement) ElementType() string {
+	return ElementTypeChannelsSelect
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e ChannelsSelectElement) MarshalJSON() ([]byte, error) {
+	type alias ChannelsSelectElement
+	return marshalTyped(e.ElementType(), alias(e), e.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *ChannelsSelectElement) UnmarshalJSON(data []byte) error {
+	type alias ChannelsSelectElement
+	extra, err := unmarshalTyped(data, (*alias)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+
+	return nil
+}
+
+// MultiStaticSelectElement is a multi-select menu with a static list of options.
+// See: https://api.slack.com/reference/block-kit/block-elements#static_multi_select
+type MultiStaticSelectElement struct {
+	// Placeholder is the plain text shown when nothing is selected.
+	Placeholder *TextObject `json:"placeholder,omitempty"`
+
+	// ActionID identifies the action in the interaction payload.
+	ActionID string `json:"action_id,omitempty"`
+
+	// Options are the options of the menu, either Options or OptionGroups must be set.
+	Options []*Option `json:"options,omitempty"`
+
+	// OptionGroups are the grouped options of the menu.
+	OptionGroups []*OptionGroup `json:"option_groups,omitempty"`
+
+	// InitialOptions are the options selected when the menu loads.
+	InitialOptions []*Option `json:"initial_options,omitempty"`
+
+	// MaxSelectedItems is the maximum number of items that can be selected.
+	MaxSelectedItems int `json:"max_selected_items,omitempty"`
+
+	// Confirm is an optional dialog shown after an option is selected.
+	Confirm *ConfirmationDialog `json:"confirm,omitempty"`
+
+	// FocusOnLoad if true sets focus on the element when loaded.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which MultiStaticSelectElement doesn't model.
+	// They're included when the element is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// ElementType implements Element.
+func (MultiStaticSelectElement) ElementType() string {
+	return ElementTypeMultiStaticSelect
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e MultiStaticSelectElement) MarshalJSON() ([]byte, error) {
+	type alias MultiStaticSelectElement
+	return marshalTyped(e.ElementType(), alias(e), e.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *MultiStaticSelectElement) UnmarshalJSON(data []byte) error {
+	type alias MultiStaticSelectElement
+	extra, err := unmarshalTyped(data, (*alias)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+
+	return nil
+}
+
+// MultiExternalSelectElement is a multi-select menu whose options are loaded from an external data source.
+// See: https://api.slack.com/reference/block-kit/block-elements#external_multi_select
+type MultiExternalSelectElement struct {
+	// Placeholder is the plain text shown when nothing is selected.
+	Placeholder *TextObject `json:"placeholder,omitempty"`
+
+	// ActionID identifies the action in the interaction payload.
+	ActionID string `json:"action_id,omitempty"`
+
+	// InitialOptions are the options selected when the menu loads.
+	InitialOptions []*Option `json:"initial_options,omitempty"`
+
+	// MinQueryLength is the number of characters typed before options are requested.
+	MinQueryLength int `json:"min_query_length,omitempty"`
+
+	// MaxSelectedItems is the maximum number of items that can be selected.
+	MaxSelectedItems int `json:"max_selected_items,omitempty"`
+
+	// Confirm is an optional dialog shown after an option is selected.
+	Confirm *ConfirmationDialog `json:"confirm,omitempty"`
+
+	// FocusOnLoad if true sets focus on the element when loaded.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which MultiExternalSelectElement doesn't model.
+	// They're included when the element is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// ElementType implements Element.
+func (MultiExternalSelectElement) ElementType() string {
+	return ElementTypeMultiExternalSelect
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e MultiExternalSelectElement) MarshalJSON() ([]byte, error) {
+	type alias MultiExternalSelectElement
+	return marshalTyped(e.ElementType(), alias(e), e.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *MultiExternalSelectElement) UnmarshalJSON(data []byte) error {
+	type alias MultiExternalSelectElement
+	extra, err := unmarshalTyped(data, (*alias)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+
+	return nil
+}
+
+// MultiUsersSelectElement is a multi-select menu listing the users of the workspace.
+// See: https://api.slack.com/reference/block-kit/block-elements#users_multi_select
+type MultiUsersSelectElement struct {
+	// Placeholder is the plain text shown when nothing is selected.
+	Placeholder *TextObject `json:"placeholder,omitempty"`
+
+	// ActionID identifies the action in the interaction payload.
+	ActionID string `json:"action_id,omitempty"`
+
+	// InitialUsers are the IDs of the users selected when the menu loads.
+	InitialUsers []string `json:"initial_users,omitempty"`
+
+	// MaxSelectedItems is the maximum number of items that can be selected.
+	MaxSelectedItems int `json:"max_selected_items,omitempty"`
+
+	// Confirm is an optional dialog shown after a user is selected.
+	Confirm *ConfirmationDialog `json:"confirm,omitempty"`
+
+	// FocusOnLoad if true sets focus on the element when loaded.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which MultiUsersSelectElement doesn't model.
+	// They're included when the element is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// ElementType implements Element.
+func (MultiUsersSelectElement) ElementType() string {
+	return ElementTypeMultiUsersSelect
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e MultiUsersSelectElement) MarshalJSON() ([]byte, error) {
+	type alias MultiUsersSelectElement
+	return marshalTyped(e.ElementType(), alias(e), e.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *MultiUsersSelectElement) UnmarshalJSON(data []byte) error {
+	type alias MultiUsersSelectElement
+	extra, err := unmarshalTyped(data, (*alias)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+
+	return nil
+}
+
+// MultiConversationsSelectElement is a multi-select menu listing public and private channels, DMs and MPIMs.
+// See: https://api.slack.com/reference/block-kit/block-elements#conversation_multi_select
+type MultiConversationsSelectElement struct {
+	// Placeholder is the plain text shown when nothing is selected.
+	Placeholder *TextObject `json:"placeholder,omitempty"`
+
+	// ActionID identifies the action in the interaction payload.
+	ActionID string `json:"action_id,omitempty"`
+
+	// InitialConversations are the IDs of the conversations selected when the menu loads.
+	InitialConversations []string `json:"initial_conversations,omitempty"`
+
+	// DefaultToCurrentConversation if true selects the current conversation when the menu loads.
+	DefaultToCurrentConversation bool `json:"default_to_current_conversation,omitempty"`
+
+	// MaxSelectedItems is the maximum number of items that can be selected.
+	MaxSelectedItems int `json:"max_selected_items,omitempty"`
+
+	// Filter limits the conversations listed.
+	Filter *Filter `json:"filter,omitempty"`
+
+	// Confirm is an optional dialog shown after a conversation is selected.
+	Confirm *ConfirmationDialog `json:"confirm,omitempty"`
+
+	// FocusOnLoad if true sets focus on the element when loaded.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which MultiConversationsSelectElement doesn't model.
+	// They're included when the element is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// ElementType implements Element.
+func (MultiConversationsSelectElement) ElementType() string {
+	return ElementTypeMultiConversationsSelect
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e MultiConversationsSelectElement) MarshalJSON() ([]byte, error) {
+	type alias MultiConversationsSelectElement
+	return marshalTyped(e.ElementType(), alias(e), e.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *MultiConversationsSelectElement) UnmarshalJSON(data []byte) error {
+	type alias MultiConversationsSelectElement
+	extra, err := unmarshalTyped(data, (*alias)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+
+	return nil
+}
+
+// MultiChannelsSelectElement is a multi-select menu listing the public channels of the workspace.
+// See: https://api.slack.com/reference/block-kit/block-elements#channel_multi_select
+type MultiChannelsSelectElement struct {
+	// Placeholder is the plain text shown when nothing is selected.
+	Placeholder *TextObject `json:"placeholder,omitempty"`
+
+	// ActionID identifies the action in the interaction payload.
+	ActionID string `json:"action_id,omitempty"`
+
+	// InitialChannels are the IDs of the channels selected when the menu loads.
+	InitialChannels []string `json:"initial_channels,omitempty"`
+
+	// MaxSelectedItems is the maximum number of items that can be selected.
+	MaxSelectedItems int `json:"max_selected_items,omitempty"`
+
+	// Confirm is an optional dialog shown after a channel is selected.
+	Confirm *ConfirmationDialog `json:"confirm,omitempty"`
+
+	// FocusOnLoad if true sets focus on the element when loaded.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which MultiChannelsSelectElement doesn't model.
+	// They're included when the element is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// ElementType implements Element.
+func (MultiChannelsSelectElement) ElementType() string {
+	return ElementTypeMultiChannelsSelect
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e MultiChannelsSelectElement) MarshalJSON() ([]byte, error) {
+	type alias MultiChannelsSelectElement
+	return marshalTyped(e.ElementType(), alias(e), e.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *MultiChannelsSelectElement) UnmarshalJSON(data []byte) error {
+	type alias MultiChannelsSelectElement
+	extra, err := unmarshalTyped(data, (*alias)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+
+	return nil
+}
+
+// OverflowElement is a compact menu of up to five options, shown as a button with three dots.
+// See: https://api.slack.com/reference/block-kit/block-elements#overflow
+type OverflowElement struct {
+	// ActionID identifies the action in the interaction payload.
+	ActionID string `json:"action_id,omitempty"`
+
+	// Options are the options of the menu, which may have a URL.
+	Options []*Option `json:"options"`
+
+	// Confirm is an optional dialog shown after an option is selected.
+	Confirm *ConfirmationDialog `json:"confirm,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which OverflowElement doesn't model.
+	// They're included when the element is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// ElementType implements Element.
+func (OverflowElement) ElementType() string {
+	return ElementTypeOverflow
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e OverflowElement) MarshalJSON() ([]byte, error) {
+	type alias OverflowElement
+	return marshalTyped(e.ElementType(), alias(e), e.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *OverflowElement) UnmarshalJSON(data []byte) error {
+	type alias OverflowElement
+	extra, err := unmarshalTyped(data, (*alias)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+
+	return nil
+}
+
+// DatePickerElement is a calendar date picker.
+// See: https://api.slack.com/reference/block-kit/block-elements#datepicker
+type DatePickerElement struct {
+	// ActionID identifies the action in the interaction payload.
+	ActionID string `json:"action_id,omitempty"`
+
+	// Placeholder is the plain text shown when no date is selected.
+	Placeholder *TextObject `json:"placeholder,omitempty"`
+
+	// InitialDate is the date, in the format YYYY-MM-DD, selected when the picker loads.
+	InitialDate string `json:"initial_date,omitempty"`
+
+	// Confirm is an optional dialog shown after a date is selected.
+	Confirm *ConfirmationDialog `json:"confirm,omitempty"`
+
+	// FocusOnLoad if true sets focus on the element when loaded.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which DatePickerElement doesn't model.
+	// They're included when the element is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// ElementType implements Element.
+func (DatePickerElement) ElementType() string {
+	return ElementTypeDatePicker
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e DatePickerElement) MarshalJSON() ([]byte, error) {
+	type alias DatePickerElement
+	return marshalTyped(e.ElementType(), alias(e), e.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *DatePickerElement) UnmarshalJSON(data []byte) error {
+	type alias DatePickerElement
+	extra, err := unmarshalTyped(data, (*alias)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+
+	return nil
+}
+
+// TimePickerElement is a time picker.
+// See: https://api.slack.com/reference/block-kit/block-elements#timepicker
+type TimePickerElement struct {
+	// ActionID identifies the action in the interaction payload.
+	ActionID string `json:"action_id,omitempty"`
+
+	// Placeholder is the plain text shown when no time is selected.
+	Placeholder *TextObject `json:"placeholder,omitempty"`
+
+	// InitialTime is the time, in the format HH:mm, selected when the picker loads.
+	InitialTime string `json:"initial_time,omitempty"`
+
+	// Timezone is the IANA time zone of the picker e.g. Europe/London.
+	Timezone string `json:"timezone,omitempty"`
+
+	// Confirm is an optional dialog shown after a time is selected.
+	Confirm *ConfirmationDialog `json:"confirm,omitempty"`
+
+	// FocusOnLoad if true sets focus on the element when loaded.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which TimePickerElement doesn't model.
+	// They're included when the element is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// ElementType implements Element.
+func (TimePickerElement) ElementType() string {
+	return ElementTypeTimePicker
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e TimePickerElement) MarshalJSON() ([]byte, error) {
+	type alias TimePickerElement
+	return marshalTyped(e.ElementType(), alias(e), e.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *TimePickerElement) UnmarshalJSON(data []byte) error {
+	type alias TimePickerElement
+	extra, err := unmarshalTyped(data, (*alias)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+
+	return nil
+}
+
+// DateTimePickerElement is a combined date and time picker.
+// See: https://api.slack.com/reference/block-kit/block-elements#datetimepicker
+type DateTimePickerElement struct {
+	// ActionID identifies the action in the interaction payload.
+	ActionID string `json:"action_id,omitempty"`
+
+	// InitialDateTime is the unix timestamp selected when the picker loads.
+	InitialDateTime int64 `json:"initial_date_time,omitempty"`
+
+	// Confirm is an optional dialog shown after a date and time is selected.
+	Confirm *ConfirmationDialog `json:"confirm,omitempty"`
+
+	// FocusOnLoad if true sets focus on the element when loaded.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which DateTimePickerElement doesn't model.
+	// They're included when the element is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// ElementType implements Element.
+func (DateTimePickerElement) ElementType() string {
+	return ElementTypeDateTimePicker
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e DateTimePickerElement) MarshalJSON() ([]byte, error) {
+	type alias DateTimePickerElement
+	return marshalTyped(e.ElementType(), alias(e), e.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *DateTimePickerElement) UnmarshalJSON(data []byte) error {
+	type alias DateTimePickerElement
+	extra, err := unmarshalTyped(data, (*alias)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+
+	return nil
+}
+
+// CheckboxesElement is a group of checkboxes.
+// See: https://api.slack.com/reference/block-kit/block-elements#checkboxes
+type CheckboxesElement struct {
+	// ActionID identifies the action in the interaction payload.
+	ActionID string `json:"action_id,omitempty"`
+
+	// Options are the checkboxes of the group.
+	Options []*Option `json:"options"`
+
+	// InitialOptions are the options checked when the group loads.
+	InitialOptions []*Option `json:"initial_options,omitempty"`
+
+	// Confirm is an optional dialog shown after a checkbox is clicked.
+	Confirm *ConfirmationDialog `json:"confirm,omitempty"`
+
+	// FocusOnLoad if true sets focus on the element when loaded.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which CheckboxesElement doesn't model.
+	// They're included when the element is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// ElementType implements Element.
+func (CheckboxesElement) ElementType() string {
+	return ElementTypeCheckboxes
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e CheckboxesElement) MarshalJSON() ([]byte, error) {
+	type alias CheckboxesElement
+	return marshalTyped(e.ElementType(), alias(e), e.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *CheckboxesElement) UnmarshalJSON(data []byte) error {
+	type alias CheckboxesElement
+	extra, err := unmarshalTyped(data, (*alias)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+
+	return nil
+}
+
+// RadioButtonsElement is a group of radio buttons.
+// See: https://api.slack.com/reference/block-kit/block-elements#radio
+type RadioButtonsElement struct {
+	// ActionID identifies the action in the interaction payload.
+	ActionID string `json:"action_id,omitempty"`
+
+	// Options are the radio buttons of the group.
+	Options []*Option `json:"options"`
+
+	// InitialOption is the option selected when the group loads.
+	InitialOption *Option `json:"initial_option,omitempty"`
+
+	// Confirm is an optional dialog shown after an option is selected.
+	Confirm *ConfirmationDialog `json:"confirm,omitempty"`
+
+	// FocusOnLoad if true sets focus on the element when loaded.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which RadioButtonsElement doesn't model.
+	// They're included when the element is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// ElementType implements Element.
+func (RadioButtonsElement) ElementType() string {
+	return ElementTypeRadioButtons
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e RadioButtonsElement) MarshalJSON() ([]byte, error) {
+	type alias RadioButtonsElement
+	return marshalTyped(e.ElementType(), alias(e), e.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *RadioButtonsElement) UnmarshalJSON(data []byte) error {
+	type alias RadioButtonsElement
+	extra, err := unmarshalTyped(data, (*alias)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+
+	return nil
+}
+
+// PlainTextInputElement is a free form text input.
+// See: https://api.slack.com/reference/block-kit/block-elements#input
+type PlainTextInputElement struct {
+	// ActionID identifies the action in the interaction payload.
+	ActionID string `json:"action_id,omitempty"`
+
+	// Placeholder is the plain text shown when the input is empty.
+	Placeholder *TextObject `json:"placeholder,omitempty"`
+
+	// InitialValue is the text of the input when it loads.
+	InitialValue string `json:"initial_value,omitempty"`
+
+	// Multiline if true displays a larger, multi-line input.
+	Multiline bool `json:"multiline,omitempty"`
+
+	// MinLength is the minimum length of the input.
+	MinLength int `json:"min_length,omitempty"`
+
+	// MaxLength is the maximum length of the input.
+	MaxLength int `json:"max_length,omitempty"`
+
+	// DispatchActionConfig determines when a block_actions payload is sent.
+	DispatchActionConfig *DispatchActionConfig `json:"dispatch_action_config,omitempty"`
+
+	// FocusOnLoad if true sets focus on the element when loaded.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which PlainTextInputElement doesn't model.
+	// They're included when the element is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// ElementType implements Element.
+func (PlainTextInputElement) ElementType() string {
+	return ElementTypePlainTextInput
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e PlainTextInputElement) MarshalJSON() ([]byte, error) {
+	type alias PlainTextInputElement
+	return marshalTyped(e.ElementType(), alias(e), e.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *PlainTextInputElement) UnmarshalJSON(data []byte) error {
+	type alias PlainTextInputElement
+	extra, err := unmarshalTyped(data, (*alias)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+
+	return nil
+}
+
+// EmailInputElement is an email address input.
+// See: https://api.slack.com/reference/block-kit/block-elements#email
+type EmailInputElement struct {
+	// ActionID identifies the action in the interaction payload.
+	ActionID string `json:"action_id,omitempty"`
+
+	// Placeholder is the plain text shown when the input is empty.
+	Placeholder *TextObject `json:"placeholder,omitempty"`
+
+	// InitialValue is the email address of the input when it loads.
+	InitialValue string `json:"initial_value,omitempty"`
+
+	// DispatchActionConfig determines when a block_actions payload is sent.
+	DispatchActionConfig *DispatchActionConfig `json:"dispatch_action_config,omitempty"`
+
+	// FocusOnLoad if true sets focus on the element when loaded.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which EmailInputElement doesn't model.
+	// They're included when the element is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// ElementType implements Element.
+func (EmailInputElement) ElementType() string {
+	return ElementTypeEmailInput
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e EmailInputElement) MarshalJSON() ([]byte, error) {
+	type alias EmailInputElement
+	return marshalTyped(e.ElementType(), alias(e), e.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *EmailInputElement) UnmarshalJSON(data []byte) error {
+	type alias EmailInputElement
+	extra, err := unmarshalTyped(data, (*alias)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+
+	return nil
+}
+
+// URLInputElement is a URL input.
+// See: https://api.slack.com/reference/block-kit/block-elements#url
+type URLInputElement struct {
+	// ActionID identifies the action in the interaction payload.
+	ActionID string `json:"action_id,omitempty"`
+
+	// Placeholder is the plain text shown when the input is empty.
+	Placeholder *TextObject `json:"placeholder,omitempty"`
+
+	// InitialValue is the URL of the input when it loads.
+	InitialValue string `json:"initial_value,omitempty"`
+
+	// DispatchActionConfig determines when a block_actions payload is sent.
+	DispatchActionConfig *DispatchActionConfig `json:"dispatch_action_config,omitempty"`
+
+	// FocusOnLoad if true sets focus on the element when loaded.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which URLInputElement doesn't model.
+	// They're included when the element is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// ElementType implements Element.
+func (URLInputElement) ElementType() string {
+	return ElementTypeURLInput
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e URLInputElement) MarshalJSON() ([]byte, error) {
+	type alias URLInputElement
+	return marshalTyped(e.ElementType(), alias(e), e.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *URLInputElement) UnmarshalJSON(data []byte) error {
+	type alias URLInputElement
+	extra, err := unmarshalTyped(data, (*alias)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+
+	return nil
+}
+
+// NumberInputElement is a number input.
+// See: https://api.slack.com/reference/block-kit/block-elements#number
+type NumberInputElement struct {
+	// IsDecimalAllowed if true allows decimal numbers to be entered.
+	IsDecimalAllowed bool `json:"is_decimal_allowed"`
+
+	// ActionID identifies the action in the interaction payload.
+	ActionID string `json:"action_id,omitempty"`
+
+	// Placeholder is the plain text shown when the input is empty.
+	Placeholder *TextObject `json:"placeholder,omitempty"`
+
+	// InitialValue is the number of the input when it loads.
+	InitialValue string `json:"initial_value,omitempty"`
+
+	// MinValue is the minimum value of the input.
+	MinValue string `json:"min_value,omitempty"`
+
+	// MaxValue is the maximum value of the input.
+	MaxValue string `json:"max_value,omitempty"`
+
+	// DispatchActionConfig determines when a block_actions payload is sent.
+	DispatchActionConfig *DispatchActionConfig `json:"dispatch_action_config,omitempty"`
+
+	// FocusOnLoad if true sets focus on the element when loaded.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which NumberInputElement doesn't model.
+	// They're included when the element is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// ElementType implements Element.
+func (NumberInputElement) ElementType() string {
+	return ElementTypeNumberInput
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e NumberInputElement) MarshalJSON() ([]byte, error) {
+	type alias NumberInputElement
+	return marshalTyped(e.ElementType(), alias(e), e.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *NumberInputElement) UnmarshalJSON(data []byte) error {
+	type alias NumberInputElement
+	extra, err := unmarshalTyped(data, (*alias)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package chat
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInteractiveElements(t *testing.T) {
+	confirm := NewConfirmationDialog("Sure?", "Really?", "Yes", "No")
+	opts := []*Option{NewOption("One", "1"), NewOption("Two", "2")}
+	placeholder := NewPlainText("Pick")
+	dispatch := &DispatchActionConfig{TriggerActionsOn: []string{"on_enter_pressed"}}
+
+	tests := []struct {
+		element Element
+		json    string
+	}{
+		{
+			&ButtonElement{Text: NewPlainText("Ack"), ActionID: "ack", Value: "1", Style: StylePrimary, Confirm: confirm},
+			`{"type":"button","text":{"type":"plain_text","text":"Ack"},"action_id":"ack","value":"1","style":"primary",
+			"confirm":{"title":{"type":"plain_text","text":"Sure?"},"text":{"type":"plain_text","text":"Really?"},
+			"confirm":{"type":"plain_text","text":"Yes"},"deny":{"type":"plain_text","text":"No"}}}`,
+		},
+		{
+			&StaticSelectElement{ActionID: "s", Placeholder: placeholder, Options: opts, InitialOption: opts[1]},
+			`{"type":"static_select","action_id":"s","placeholder":{"type":"plain_text","text":"Pick"},
+			"options":[{"text":{"type":"plain_text","text":"One"},"value":"1"},{"text":{"type":"plain_text","text":"Two"},"value":"2"}],
+			"initial_option":{"text":{"type":"plain_text","text":"Two"},"value":"2"}}`,
+		},
+		{
+			&StaticSelectElement{ActionID: "g", OptionGroups: []*OptionGroup{NewOptionGroup("G", opts[0])}},
+			`{"type":"static_select","action_id":"g","option_groups":[{"label":{"type":"plain_text","text":"G"},
+			"options":[{"text":{"type":"plain_text","text":"One"},"value":"1"}]}]}`,
+		},
+		{
+			&ExternalSelectElement{ActionID: "e", MinQueryLength: 3},
+			`{"type":"external_select","action_id":"e","min_query_length":3}`,
+		},
+		{
+			&UsersSelectElement{ActionID: "u", InitialUser: "U123"},
+			`{"type":"users_select","action_id":"u","initial_user":"U123"}`,
+		},
+		{
+			&ConversationsSelectElement{ActionID: "c", DefaultToCurrentConversation: true, Filter: &Filter{Include: []string{"public"}}},
+			`{"type":"conversations_select","action_id":"c","default_to_current_conversation":true,"filter":{"include":["public"]}}`,
+		},
+		{
+			&ChannelsSelectElement{ActionID: "ch", InitialChannel: "C123"},
+			`{"type":"channels_select","action_id":"ch","initial_channel":"C123"}`,
+		},
+		{
+			&MultiStaticSelectElement{ActionID: "ms", Options: opts, InitialOptions: opts[:1], MaxSelectedItems: 2},
+			`{"type":"multi_static_select","action_id":"ms",
+			"options":[{"text":{"type":"plain_text","text":"One"},"value":"1"},{"text":{"type":"plain_text","text":"Two"},"value":"2"}],
+			"initial_options":[{"text":{"type":"plain_text","text":"One"},"value":"1"}],"max_selected_items":2}`,
+		},
+		{
+			&MultiExternalSelectElement{ActionID: "me", MinQueryLength: 1},
+			`{"type":"multi_external_select","action_id":"me","min_query_length":1}`,
+		},
+		{
+			&MultiUsersSelectElement{ActionID: "mu", InitialUsers: []string{"U1", "U2"}},
+			`{"type":"multi_users_select","action_id":"mu","initial_users":["U1","U2"]}`,
+		},
+		{
+			&MultiConversationsSelectElement{ActionID: "mc", InitialConversations: []string{"C1"}},
+			`{"type":"multi_conversations_select","action_id":"mc","initial_conversations":["C1"]}`,
+		},
+		{
+			&MultiChannelsSelectElement{ActionID: "mch", InitialChannels: []string{"C1"}},
+			`{"type":"multi_channels_select","action_id":"mch","initial_channels":["C1"]}`,
+		},
+		{
+			&OverflowElement{ActionID: "o", Options: []*Option{{Text: NewPlainText("Docs"), Value: "docs", URL: "https://example.com"}}},
+			`{"type":"overflow","action_id":"o","options":[{"text":{"type":"plain_text","text":"Docs"},"value":"docs","url":"https://example.com"}]}`,
+		},
+		{
+			&DatePickerElement{ActionID: "d", InitialDate: "2020-01-02"},
+			`{"type":"datepicker","action_id":"d","initial_date":"2020-01-02"}`,
+		},
+		{
+			&TimePickerElement{ActionID: "t", InitialTime: "12:30", Timezone: "Europe/London"},
+			`{"type":"timepicker","action_id":"t","initial_time":"12:30","timezone":"Europe/London"}`,
+		},
+		{
+			&DateTimePickerElement{ActionID: "dt", InitialDateTime: 1628633820},
+			`{"type":"datetimepicker","action_id":"dt","initial_date_time":1628633820}`,
+		},
+		{
+			&CheckboxesElement{ActionID: "cb", Options: opts[:1], InitialOptions: opts[:1]},
+			`{"type":"checkboxes","action_id":"cb","options":[{"text":{"type":"plain_text","text":"One"},"value":"1"}],
+			"initial_options":[{"text":{"type":"plain_text","text":"One"},"value":"1"}]}`,
+		},
+		{
+			&RadioButtonsElement{ActionID: "r", Options: opts[:1], InitialOption: opts[0]},
+			`{"type":"radio_buttons","action_id":"r","options":[{"text":{"type":"plain_text","text":"One"},"value":"1"}],
+			"initial_option":{"text":{"type":"plain_text","text":"One"},"value":"1"}}`,
+		},
+		{
+			&PlainTextInputElement{ActionID: "p", Multiline: true, MaxLength: 100, DispatchActionConfig: dispatch},
+			`{"type":"plain_text_input","action_id":"p","multiline":true,"max_length":100,
+			"dispatch_action_config":{"trigger_actions_on":["on_enter_pressed"]}}`,
+		},
+		{
+			&EmailInputElement{ActionID: "em", InitialValue: "a@example.com"},
+			`{"type":"email_text_input","action_id":"em","initial_value":"a@example.com"}`,
+		},
+		{
+			&URLInputElement{ActionID: "url", InitialValue: "https://example.com"},
+			`{"type":"url_text_input","action_id":"url","initial_value":"https://example.com"}`,
+		},
+		{
+			&NumberInputElement{ActionID: "n"},
+			`{"type":"number_input","is_decimal_allowed":false,"action_id":"n"}`,
+		},
+		{
+			&NumberInputElement{ActionID: "n", IsDecimalAllowed: true, MinValue: "0.5", MaxValue: "10"},
+			`{"type":"number_input","is_decimal_allowed":true,"action_id":"n","min_value":"0.5","max_value":"10"}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.element.ElementType(), func(t *testing.T) {
+			b, err := json.Marshal(tc.element)
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.JSONEq(t, tc.json, string(b))
+
+			var es Elements
+			if assert.NoError(t, json.Unmarshal([]byte("["+string(b)+"]"), &es)) {
+				assert.Equal(t, Elements{tc.element}, es)
+			}
+		})
+	}
+}
+
+func TestNewButton(t *testing.T) {
+	b := NewButton("ack", "Ack", "alert-1")
+	assert.Equal(t, "ack", b.ActionID)
+	assert.Equal(t, NewPlainText("Ack"), b.Text)
+	assert.Equal(t, "alert-1", b.Value)
+}
+
+func ExampleButtonElement() {
+	silence := NewButton("silence", "Silence 1h", "alert-1")
+	silence.Style = StyleDanger
+	silence.Confirm = NewConfirmationDialog("Silence alert?", "No notifications will be sent for 1 hour.", "Silence", "Cancel")
+
+	runbook := NewButton("runbook", "Open runbook", "")
+	runbook.URL = "https://runbooks.example.com/high-latency"
+
+	m := &Message{Text: "High latency on api"}
+	m.AddBlock(&SectionBlock{Text: NewMarkdownText("*High latency* on `api`")})
+	m.AddBlock(&ActionsBlock{BlockID: "alert-1", Elements: Elements{
+		NewButton("ack", "Ack", "alert-1"),
+		silence,
+		runbook,
+	}})
+
+	b, _ := json.Marshal(m.Blocks[1])
+	fmt.Println(string(b))
+	// Output:
+	// {"type":"actions","block_id":"alert-1","elements":[{"type":"button","text":{"type":"plain_text","text":"Ack"},"action_id":"ack","value":"alert-1"},{"type":"button","text":{"type":"plain_text","text":"Silence 1h"},"action_id":"silence","value":"alert-1","style":"danger","confirm":{"title":{"type":"plain_text","text":"Silence alert?"},"text":{"type":"plain_text","text":"No notifications will be sent for 1 hour."},"confirm":{"type":"plain_text","text":"Silence"},"deny":{"type":"plain_text","text":"Cancel"}}},{"type":"button","text":{"type":"plain_text","text":"Open runbook"},"action_id":"runbook","url":"https://runbooks.example.com/high-latency"}]}
+}
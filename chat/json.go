@@ -0,0 +1,262 @@
+package chat
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// marshalTyped returns the JSON encoding of v with a leading "type" field of typ
+// and the fields of extra which aren't already present.
+// It's used by types whose Block Kit type is determined by their Go type.
+func marshalTyped(typ string, v interface{}, extra map[string]json.RawMessage) ([]byte, error) {
+	b, err := marshalExtra(v, extra)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := json.Marshal(typ)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBufferString(`{"type":`)
+	buf.Write(t)
+	if len(b) > 2 {
+		buf.WriteByte(',')
+	}
+	buf.Write(b[1:])
+
+	return buf.Bytes(), nil
+}
+
+// unmarshalTyped is unmarshalExtra for types encoded by marshalTyped, so it
+// excludes the "type" field from the returned fields.
+func unmarshalTyped(data []byte, v interface{}) (map[string]json.RawMessage, error) {
+	extra, err := unmarshalExtra(data, v)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(extra, "type")
+	if len(extra) == 0 {
+		return nil, nil
+	}
+
+	return extra, nil
+}
+
+// isNull returns true if data is empty or a JSON null.
+func isNull(data []byte) bool {
+	d := bytes.TrimSpace(data)
+	return len(d) == 0 || bytes.Equal(d, []byte("null"))
+}
+
+// decodeTyped decodes the JSON object data into a new value created by the
+// entry in types matching its "type" field. kind is used in error messages.
+// It returns the zero value of T if data is null.
+func decodeTyped[T any](data []byte, types map[string]func() T, kind string) (T, error) {
+	var zero T
+	if isNull(data) {
+		return zero, nil
+	}
+
+	var t struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &t); err != nil {
+		return zero, err
+	}
+
+	f, ok := types[t.Type]
+	if !ok {
+		// Preserve types we don't model so they survive a decode/encode cycle.
+		u := &Unknown{Type: t.Type, Raw: append(json.RawMessage(nil), data...)}
+		if v, ok := interface{}(u).(T); ok {
+			return v, nil
+		}
+		return zero, fmt.Errorf("chat: unknown %v type %q", kind, t.Type)
+	}
+
+	v := f()
+	if err := json.Unmarshal(data, v); err != nil {
+		return zero, err
+	}
+
+	return v, nil
+}
+
+// decodeTypedSlice decodes the JSON array data using decodeTyped for each item.
+func decodeTypedSlice[T any](data []byte, types map[string]func() T, kind string) ([]T, error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		return nil, nil
+	}
+
+	s := make([]T, len(raw))
+	for i, r := range raw {
+		v, err := decodeTyped(r, types, kind)
+		if err != nil {
+			return nil, err
+		}
+		s[i] = v
+	}
+
+	return s, nil
+}
+
+// marshalExtra returns the JSON encoding of v with the fields of extra
+// which aren't already present added in key order.
+func marshalExtra(v interface{}, extra map[string]json.RawMessage) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil || len(extra) == 0 {
+		return b, err
+	}
+
+	var known map[string]json.RawMessage
+	if err := json.Unmarshal(b, &known); err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(extra))
+	for k := range extra {
+		if _, ok := known[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	if len(keys) == 0 {
+		return b, nil
+	}
+	sort.Strings(keys)
+
+	buf := bytes.NewBuffer(b[:len(b)-1])
+	for i, k := range keys {
+		if i > 0 || len(known) > 0 {
+			buf.WriteByte(',')
+		}
+		kb, err := json.Marshal(k)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(kb)
+		buf.WriteByte(':')
+		buf.Write(extra[k])
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+// unmarshalExtra decodes the JSON object data into v and returns the fields
+// of data which aren't present when v is encoded again. These include fields
+// which v doesn't model and omitempty fields set to their zero value.
+func unmarshalExtra(data []byte, v interface{}) (map[string]json.RawMessage, error) {
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var known map[string]json.RawMessage
+	if err := json.Unmarshal(b, &known); err != nil {
+		return nil, err
+	}
+
+	for k := range known {
+		delete(all, k)
+	}
+	if len(all) == 0 {
+		return nil, nil
+	}
+
+	return all, nil
+}
+
+// marshalWith returns the JSON encoding of m with the fields of with added,
+// replacing any fields of m.Extra with the same name. It's used by requests
+// which embed Message as the promoted Message.MarshalJSON would omit their
+// own fields.
+func marshalWith(m Message, with map[string]interface{}) ([]byte, error) {
+	extra := make(map[string]json.RawMessage, len(m.Extra)+len(with))
+	for k, v := range m.Extra {
+		extra[k] = v
+	}
+	for k, v := range with {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		extra[k] = b
+	}
+	m.Extra = extra
+
+	return json.Marshal(m)
+}
+
+// unmarshalWith decodes the JSON object data into m, decoding the fields
+// named by with into the values they point to instead of into m.Extra.
+func unmarshalWith(data []byte, m *Message, with map[string]interface{}) error {
+	if err := json.Unmarshal(data, m); err != nil {
+		return err
+	}
+
+	for k, v := range with {
+		raw, ok := m.Extra[k]
+		if !ok {
+			continue
+		}
+		if err := json.Unmarshal(raw, v); err != nil {
+			return err
+		}
+		delete(m.Extra, k)
+	}
+	if len(m.Extra) == 0 {
+		m.Extra = nil
+	}
+
+	return nil
+}
+
+// unixTime is a time encoded in JSON as a unix timestamp in seconds.
+// It decodes from either a JSON number or string as slack uses both.
+type unixTime time.Time
+
+// MarshalJSON implements json.Marshaler.
+func (t unixTime) MarshalJSON() ([]byte, error) {
+	if time.Time(t).IsZero() {
+		return []byte("0"), nil
+	}
+
+	return json.Marshal(time.Time(t).Unix())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *unixTime) UnmarshalJSON(data []byte) error {
+	s := string(bytes.Trim(data, `"`))
+	if s == "" || s == "0" || s == "null" {
+		*t = unixTime{}
+		return nil
+	}
+
+	sec, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return fmt.Errorf("chat: invalid unix time %s", data)
+	}
+	*t = unixTime(time.Unix(sec, 0))
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package chat
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshalTyped(t *testing.T) {
+	b, err := marshalTyped("divider", struct{}{}, nil)
+	if assert.NoError(t, err) {
+		assert.Equal(t, `{"type":"divider"}`, string(b))
+	}
+
+	b, err = marshalTyped("header", struct {
+		Text string `json:"text"`
+	}{Text: "hello"}, map[string]json.RawMessage{"text": []byte(`"ignored"`), "x": []byte("1")})
+	if assert.NoError(t, err) {
+		assert.Equal(t, `{"type":"header","text":"hello","x":1}`, string(b))
+	}
+
+	_, err = marshalTyped("invalid", func() {}, nil)
+	assert.Error(t, err)
+}
+
+func TestDecodeTyped(t *testing.T) {
+	b, err := decodeTyped([]byte(`{"type":"divider","block_id":"b1"}`), blockTypes, "block")
+	if assert.NoError(t, err) {
+		assert.Equal(t, &DividerBlock{BlockID: "b1"}, b)
+	}
+
+	b, err = decodeTyped([]byte(`null`), blockTypes, "block")
+	assert.NoError(t, err)
+	assert.Nil(t, b)
+
+	b, err = decodeTyped([]byte(`{"type":"video","title":"t"}`), blockTypes, "block")
+	if assert.NoError(t, err) {
+		assert.Equal(t, &Unknown{Type: "video", Raw: []byte(`{"type":"video","title":"t"}`)}, b)
+	}
+
+	// Types which Unknown doesn't implement still error.
+	_, err = decodeTyped([]byte(`{"type":"invalid"}`), map[string]func() *Field{}, "field")
+	assert.EqualError(t, err, `chat: unknown field type "invalid"`)
+
+	_, err = decodeTyped([]byte(`[]`), blockTypes, "block")
+	assert.Error(t, err)
+
+	_, err = decodeTyped([]byte(`{"type":"header","text":1}`), blockTypes, "block")
+	assert.Error(t, err)
+}
+
+func TestDecodeTypedSlice(t *testing.T) {
+	s, err := decodeTypedSlice([]byte(`[{"type":"divider"},{"type":"file","external_id":"F1","source":"remote"}]`), blockTypes, "block")
+	if assert.NoError(t, err) {
+		assert.Equal(t, []Block{&DividerBlock{}, &FileBlock{ExternalID: "F1", Source: "remote"}}, s)
+	}
+
+	s, err = decodeTypedSlice([]byte(`null`), blockTypes, "block")
+	assert.NoError(t, err)
+	assert.Nil(t, s)
+
+	_, err = decodeTypedSlice([]byte(`{}`), blockTypes, "block")
+	assert.Error(t, err)
+
+	_, err = decodeTypedSlice([]byte(`[{"type":"invalid"}]`), map[string]func() *Field{}, "field")
+	assert.Error(t, err)
+}
+
+func TestMarshalExtra(t *testing.T) {
+	v := struct {
+		A string `json:"a"`
+	}{A: "a"}
+
+	b, err := marshalExtra(v, nil)
+	if assert.NoError(t, err) {
+		assert.Equal(t, `{"a":"a"}`, string(b))
+	}
+
+	b, err = marshalExtra(v, map[string]json.RawMessage{"c": []byte(`3`), "a": []byte(`"x"`), "b": []byte(`[2]`)})
+	if assert.NoError(t, err) {
+		assert.Equal(t, `{"a":"a","b":[2],"c":3}`, string(b))
+	}
+
+	b, err = marshalExtra(struct{}{}, map[string]json.RawMessage{"b": []byte(`2`), "a": []byte(`1`)})
+	if assert.NoError(t, err) {
+		assert.Equal(t, `{"a":1,"b":2}`, string(b))
+	}
+
+	b, err = marshalExtra(v, map[string]json.RawMessage{"a": []byte(`"x"`)})
+	if assert.NoError(t, err) {
+		assert.Equal(t, `{"a":"a"}`, string(b))
+	}
+
+	_, err = marshalExtra(func() {}, nil)
+	assert.Error(t, err)
+}
+
+func TestUnmarshalExtra(t *testing.T) {
+	v := &struct {
+		A string `json:"a"`
+		B bool   `json:"b,omitempty"`
+	}{}
+
+	extra, err := unmarshalExtra([]byte(`{"a":"a","b":false,"c":{"d":1}}`), v)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "a", v.A)
+		assert.Equal(t, map[string]json.RawMessage{"b": []byte(`false`), "c": []byte(`{"d":1}`)}, extra)
+	}
+
+	extra, err = unmarshalExtra([]byte(`{"a":"a","b":true}`), v)
+	assert.NoError(t, err)
+	assert.Nil(t, extra)
+
+	_, err = unmarshalExtra([]byte(`{"a":1}`), v)
+	assert.Error(t, err)
+}
+
+func TestUnmarshalTyped(t *testing.T) {
+	var v struct {
+		Text string `json:"text"`
+	}
+	extra, err := unmarshalTyped([]byte(`{"type":"header","text":"hello"}`), &v)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "hello", v.Text)
+		assert.Nil(t, extra)
+	}
+
+	extra, err = unmarshalTyped([]byte(`{"type":"header","text":"hello","x":1}`), &v)
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[string]json.RawMessage{"x": json.RawMessage("1")}, extra)
+	}
+
+	_, err = unmarshalTyped([]byte(`{"text":1}`), &v)
+	assert.Error(t, err)
+}
+
+func TestKnownTypesRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"section", `{"type":"section","text":{"type":"mrkdwn","text":"hi"},"expand":true}`},
+		{"section accessory", `{"type":"section","text":{"type":"mrkdwn","text":"hi"},"accessory":{"type":"button","text":{"type":"plain_text","text":"Go"},"action_id":"a","accessibility_label":"go"}}`},
+		{"input", `{"type":"input","label":{"type":"plain_text","text":"Name"},"element":{"type":"plain_text_input","action_id":"n","min_length":0},"optional":false}`},
+		{"emoji false", `{"type":"header","text":{"type":"plain_text","text":"hi :wave:","emoji":false}}`},
+		{"emoji true", `{"type":"header","text":{"type":"plain_text","text":"hi :wave:","emoji":true}}`},
+		{"rich text style", `{"type":"rich_text","elements":[{"type":"rich_text_section","elements":[{"type":"text","text":"hi","style":{"bold":true,"underline":true}}]}]}`},
+		{"rich text list", `{"type":"rich_text","elements":[{"type":"rich_text_list","style":"bullet","elements":[],"indent":0,"offset":2}]}`},
+		{"option", `{"type":"actions","elements":[{"type":"static_select","action_id":"s","options":[{"text":{"type":"plain_text","text":"a"},"value":"a","x":1}]}]}`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var bs Blocks
+			if !assert.NoError(t, json.Unmarshal([]byte("["+tc.data+"]"), &bs)) {
+				return
+			}
+			if !assert.Len(t, bs, 1) {
+				return
+			}
+			assert.NotEqual(t, "*chat.Unknown", fmt.Sprintf("%T", bs[0]))
+
+			b, err := json.Marshal(bs[0])
+			if assert.NoError(t, err) {
+				assert.JSONEq(t, tc.data, string(b))
+			}
+		})
+	}
+}
@@ -0,0 +1,871 @@
+package chat
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/multiplay/go-slack/mrkdwn"
+)
+
+// mdRuleText is the text used to render thematic breaks as mrkdwn, which has no equivalent.
+var mdRuleText = strings.Repeat("─", 20)
+
+var (
+	reMDFence    = regexp.MustCompile("^( {0,3})(`{3,}|~{3,})")
+	reMDHeading  = regexp.MustCompile(`^ {0,3}(#{1,6})(?:[ \t]+(.*?))?[ \t]*$`)
+	reMDClosing  = regexp.MustCompile(`(?:^|[ \t]+)#+$`)
+	reMDRule     = regexp.MustCompile(`^ {0,3}(?:(?:\*[ \t]*){3,}|(?:-[ \t]*){3,}|(?:_[ \t]*){3,})$`)
+	reMDQuote    = regexp.MustCompile(`^ {0,3}> ?`)
+	reMDList     = regexp.MustCompile(`^( {0,3})([-+*]|[0-9]{1,9}[.)])(?:( {1,4})(.*)| *$)`)
+	reMDSetext1  = regexp.MustCompile(`^ {0,3}=+[ \t]*$`)
+	reMDSetext2  = regexp.MustCompile(`^ {0,3}-+[ \t]*$`)
+	reMDDelimRow = regexp.MustCompile(`^ {0,3}\|?[ \t]*:?-+:?[ \t]*(?:\|[ \t]*:?-+:?[ \t]*)*\|?[ \t]*$`)
+	reMDRefDef   = regexp.MustCompile(`^ {0,3}\[([^\]]+)\]:[ \t]*<?([^ \t>]+)>?(?:[ \t]+(?:"[^"]*"|'[^']*'|\([^)]*\)))?[ \t]*$`)
+)
+
+// mdKind is the kind of a Markdown block.
+type mdKind int
+
+const (
+	mdParagraph mdKind = iota
+	mdHeading
+	mdCode
+	mdQuote
+	mdList
+	mdRule
+	mdTable
+)
+
+// mdNode is a parsed Markdown block.
+type mdNode struct {
+	kind mdKind
+
+	// text is the inline source of paragraphs and headings or the content of code blocks.
+	text string
+
+	// children are the blocks of a quote.
+	children []*mdNode
+
+	// ordered, start and items describe a list, each item is a list of blocks.
+	ordered bool
+	start   int
+	items   [][]*mdNode
+
+	// rows are the cells of a table, the first row being the header, with align
+	// being the alignment of each column, one of 'l', 'r' or 'c'.
+	rows  [][]string
+	align []byte
+}
+
+// markdown converts CommonMark and GitHub Flavored Markdown to mrkdwn.
+type markdown struct {
+	// refs are the link reference definitions of the document.
+	refs map[string]string
+}
+
+// MarkdownToMrkdwn converts the CommonMark or GitHub Flavored Markdown md to
+// slack's mrkdwn format, suitable for use as the Text of a Message.
+//
+// Elements which mrkdwn doesn't support are converted to the closest
+// equivalent, headings are displayed in bold and tables as preformatted text.
+//
+// mrkdwn has no escape for formatting markers, its entities only cover &, <
+// and >, so escaped markers such as \* are surrounded by zero width spaces
+// (U+200B) to stop slack treating them as formatting. They're invisible but
+// remain in the text, for example if it's copied, and count towards the
+// lengths checked by Fit and Validate.
+func MarkdownToMrkdwn(md string) string {
+	p := &markdown{refs: make(map[string]string)}
+	return p.render(p.parse(mdLines(md)), "\n\n")
+}
+
+// MarkdownToBlocks converts the CommonMark or GitHub Flavored Markdown md to
+// Block Kit blocks. Headings are converted to header blocks, thematic breaks
+// to dividers, code blocks and tables to preformatted rich text and the
+// remaining content to mrkdwn sections. Escaped formatting markers are
+// converted as described by MarkdownToMrkdwn.
+func MarkdownToBlocks(md string) Blocks {
+	p := &markdown{refs: make(map[string]string)}
+
+	var blocks Blocks
+	var text []string
+	flush := func() {
+		blocks = append(blocks, sections(text)...)
+		text = nil
+	}
+
+	for _, n := range p.parse(mdLines(md)) {
+		switch n.kind {
+		case mdHeading:
+			flush()
+			if s := p.inline(n.text, true); s != "" {
+				blocks = append(blocks, &HeaderBlock{Text: NewPlainText(Truncate(s, MaxHeaderTextLen, DefaultEllipsis))})
+			}
+		case mdRule:
+			flush()
+			blocks = append(blocks, &DividerBlock{})
+		case mdCode, mdTable:
+			flush()
+			s := strings.TrimSuffix(n.text, "\n")
+			if n.kind == mdTable {
+				s = p.table(n)
+			}
+			if s != "" {
+				blocks = append(blocks, &RichTextBlock{Elements: RichTextElements{
+					&RichTextPreformatted{Elements: RichTextInlines{&RichTextText{Text: s}}},
+				}})
+			}
+		default:
+			text = append(text, p.node(n))
+		}
+	}
+	flush()
+
+	return blocks
+}
+
+// sections returns mrkdwn sections containing text, combining consecutive
+// text into the same section while it fits and splitting text which doesn't.
+func sections(text []string) Blocks {
+	var blocks Blocks
+	var cur string
+	for _, t := range text {
+		switch {
+		case cur == "":
+			cur = t
+		case utf8.RuneCountInString(cur)+utf8.RuneCountInString(t)+2 <= MaxSectionTextLen:
+			cur += "\n\n" + t
+		default:
+			blocks = append(blocks, sectionsOf(cur)...)
+			cur = t
+		}
+	}
+	if cur != "" {
+		blocks = append(blocks, sectionsOf(cur)...)
+	}
+
+	return blocks
+}
+
+// sectionsOf returns s as one or more mrkdwn sections.
+func sectionsOf(s string) Blocks {
+	var blocks Blocks
+	for _, c := range splitText(s, MaxSectionTextLen) {
+		blocks = append(blocks, &SectionBlock{Text: NewMarkdownText(c)})
+	}
+
+	return blocks
+}
+
+// mdLines returns the lines of md with leading tabs expanded to spaces.
+func mdLines(md string) []string {
+	md = strings.ReplaceAll(md, "\r\n", "\n")
+	lines := strings.Split(strings.TrimSuffix(md, "\n"), "\n")
+	for i, l := range lines {
+		var n int
+		for n < len(l) && (l[n] == ' ' || l[n] == '\t') {
+			n++
+		}
+		if !strings.Contains(l[:n], "\t") {
+			continue
+		}
+
+		var col int
+		for _, c := range l[:n] {
+			if c == '\t' {
+				col += 4 - col%4
+			} else {
+				col++
+			}
+		}
+		lines[i] = strings.Repeat(" ", col) + l[n:]
+	}
+
+	return lines
+}
+
+func isBlank(l string) bool {
+	return strings.TrimSpace(l) == ""
+}
+
+func indent(l string) int {
+	return len(l) - len(strings.TrimLeft(l, " "))
+}
+
+// listMarker is the parsed marker of a list item.
+type listMarker struct {
+	ordered bool
+	delim   byte
+	start   int
+	indent  int
+	content string
+}
+
+// parseListMarker parses the list item marker at the start of l.
+func parseListMarker(l string) (*listMarker, bool) {
+	m := reMDList.FindStringSubmatch(l)
+	if m == nil {
+		return nil, false
+	}
+
+	lm := &listMarker{delim: m[2][len(m[2])-1], content: m[4]}
+	lm.indent = len(m[1]) + len(m[2]) + len(m[3])
+	if m[3] == "" {
+		lm.indent++
+	}
+	if lm.delim == '.' || lm.delim == ')' {
+		lm.ordered = true
+		lm.start, _ = strconv.Atoi(m[2][:len(m[2])-1])
+	}
+
+	return lm, true
+}
+
+// interrupts returns true if l starts a block which ends a paragraph.
+func interrupts(l string) bool {
+	if reMDFence.MatchString(l) || reMDHeading.MatchString(l) || reMDRule.MatchString(l) || reMDQuote.MatchString(l) {
+		return true
+	}
+
+	lm, ok := parseListMarker(l)
+	return ok && lm.content != "" && (!lm.ordered || lm.start == 1)
+}
+
+// parse parses the blocks of lines.
+func (p *markdown) parse(lines []string) []*mdNode {
+	var nodes []*mdNode
+	for i := 0; i < len(lines); {
+		l := lines[i]
+		var n *mdNode
+		switch {
+		case isBlank(l):
+			i++
+			continue
+		case reMDFence.MatchString(l):
+			n, i = p.parseFence(lines, i)
+		case reMDHeading.MatchString(l):
+			m := reMDHeading.FindStringSubmatch(l)
+			n = &mdNode{kind: mdHeading, text: reMDClosing.ReplaceAllString(m[2], "")}
+			i++
+		case reMDRule.MatchString(l):
+			n = &mdNode{kind: mdRule}
+			i++
+		case reMDQuote.MatchString(l):
+			n, i = p.parseQuote(lines, i)
+		case reMDList.MatchString(l):
+			n, i = p.parseList(lines, i)
+		case indent(l) >= 4:
+			n, i = p.parseIndentedCode(lines, i)
+		case isTable(lines, i):
+			n, i = p.parseTable(lines, i)
+		case reMDRefDef.MatchString(l):
+			m := reMDRefDef.FindStringSubmatch(l)
+			if label := strings.ToLower(m[1]); p.refs[label] == "" {
+				p.refs[label] = m[2]
+			}
+			i++
+			continue
+		default:
+			n, i = p.parseParagraph(lines, i)
+		}
+		nodes = append(nodes, n)
+	}
+
+	return nodes
+}
+
+func (p *markdown) parseFence(lines []string, i int) (*mdNode, int) {
+	m := reMDFence.FindStringSubmatch(lines[i])
+	ind, fence := len(m[1]), m[2]
+	closing := regexp.MustCompile("^ {0,3}" + regexp.QuoteMeta(fence[:1]) + "{" + strconv.Itoa(len(fence)) + ",}[ \t]*$")
+
+	var b strings.Builder
+	for i++; i < len(lines); i++ {
+		l := lines[i]
+		if closing.MatchString(l) {
+			i++
+			break
+		}
+		if n := indent(l); n < ind {
+			l = l[n:]
+		} else {
+			l = l[ind:]
+		}
+		b.WriteString(l)
+		b.WriteString("\n")
+	}
+
+	return &mdNode{kind: mdCode, text: b.String()}, i
+}
+
+func (p *markdown) parseIndentedCode(lines []string, i int) (*mdNode, int) {
+	var code []string
+	for ; i < len(lines) && (isBlank(lines[i]) || indent(lines[i]) >= 4); i++ {
+		if isBlank(lines[i]) {
+			code = append(code, "")
+		} else {
+			code = append(code, lines[i][4:])
+		}
+	}
+	for len(code) > 0 && code[len(code)-1] == "" {
+		code = code[:len(code)-1]
+	}
+
+	return &mdNode{kind: mdCode, text: strings.Join(code, "\n") + "\n"}, i
+}
+
+func (p *markdown) parseQuote(lines []string, i int) (*mdNode, int) {
+	var quoted []string
+	for ; i < len(lines); i++ {
+		l := lines[i]
+		if loc := reMDQuote.FindStringIndex(l); loc != nil {
+			quoted = append(quoted, l[loc[1]:])
+			continue
+		}
+
+		// Lazy continuation of a quoted paragraph.
+		if isBlank(l) || len(quoted) == 0 || isBlank(quoted[len(quoted)-1]) || interrupts(l) {
+			break
+		}
+		quoted = append(quoted, l)
+	}
+
+	return &mdNode{kind: mdQuote, children: p.parse(quoted)}, i
+}
+
+func (p *markdown) parseList(lines []string, i int) (*mdNode, int) {
+	first, _ := parseListMarker(lines[i])
+	n := &mdNode{kind: mdList, ordered: first.ordered, start: first.start}
+	for i < len(lines) {
+		lm, ok := parseListMarker(lines[i])
+		if !ok || lm.ordered != first.ordered || lm.delim != first.delim || reMDRule.MatchString(lines[i]) {
+			break
+		}
+
+		item := []string{lm.content}
+		for i++; i < len(lines); i++ {
+			l := lines[i]
+			switch {
+			case isBlank(l):
+				item = append(item, "")
+				continue
+			case indent(l) >= lm.indent:
+				item = append(item, l[lm.indent:])
+				continue
+			case item[len(item)-1] != "" && !interrupts(l) && !reMDList.MatchString(l):
+				// Lazy continuation of the item's paragraph.
+				item = append(item, l)
+				continue
+			}
+			break
+		}
+
+		n.items = append(n.items, p.parse(item))
+	}
+
+	return n, i
+}
+
+// isTable returns true if lines[i] is the header row of a table.
+func isTable(lines []string, i int) bool {
+	if i+1 >= len(lines) || !strings.Contains(lines[i], "|") || !reMDDelimRow.MatchString(lines[i+1]) {
+		return false
+	}
+
+	return len(splitRow(lines[i])) == len(splitRow(lines[i+1]))
+}
+
+// splitRow splits a table row into its trimmed cells.
+func splitRow(l string) []string {
+	l = strings.TrimSpace(l)
+	l = strings.TrimPrefix(l, "|")
+	if strings.HasSuffix(l, "|") && !strings.HasSuffix(l, `\|`) {
+		l = l[:len(l)-1]
+	}
+
+	var cells []string
+	var b strings.Builder
+	for i := 0; i < len(l); i++ {
+		switch {
+		case l[i] == '\\' && i+1 < len(l) && l[i+1] == '|':
+			b.WriteByte('|')
+			i++
+		case l[i] == '|':
+			cells = append(cells, strings.TrimSpace(b.String()))
+			b.Reset()
+		default:
+			b.WriteByte(l[i])
+		}
+	}
+
+	return append(cells, strings.TrimSpace(b.String()))
+}
+
+func (p *markdown) parseTable(lines []string, i int) (*mdNode, int) {
+	header := splitRow(lines[i])
+	n := &mdNode{kind: mdTable, rows: [][]string{header}}
+	for _, d := range splitRow(lines[i+1]) {
+		switch {
+		case strings.HasPrefix(d, ":") && strings.HasSuffix(d, ":"):
+			n.align = append(n.align, 'c')
+		case strings.HasSuffix(d, ":"):
+			n.align = append(n.align, 'r')
+		default:
+			n.align = append(n.align, 'l')
+		}
+	}
+
+	for i += 2; i < len(lines) && !isBlank(lines[i]) && !interrupts(lines[i]); i++ {
+		row := splitRow(lines[i])
+		for len(row) < len(header) {
+			row = append(row, "")
+		}
+		n.rows = append(n.rows, row[:len(header)])
+	}
+
+	return n, i
+}
+
+func (p *markdown) parseParagraph(lines []string, i int) (*mdNode, int) {
+	para := []string{strings.TrimLeft(lines[i], " ")}
+	for i++; i < len(lines); i++ {
+		l := lines[i]
+		switch {
+		case reMDSetext1.MatchString(l), reMDSetext2.MatchString(l):
+			return &mdNode{kind: mdHeading, text: strings.Join(para, "\n")}, i + 1
+		case isBlank(l) || interrupts(l) || isTable(lines, i):
+			return &mdNode{kind: mdParagraph, text: strings.Join(para, "\n")}, i
+		}
+		para = append(para, strings.TrimLeft(l, " "))
+	}
+
+	return &mdNode{kind: mdParagraph, text: strings.Join(para, "\n")}, i
+}
+
+// render renders nodes as mrkdwn separated by sep.
+func (p *markdown) render(nodes []*mdNode, sep string) string {
+	s := make([]string, 0, len(nodes))
+	for _, n := range nodes {
+		s = append(s, p.node(n))
+	}
+
+	return strings.Join(s, sep)
+}
+
+// node renders n as mrkdwn.
+func (p *markdown) node(n *mdNode) string {
+	switch n.kind {
+	case mdHeading:
+		return mrkdwn.Bold(mrkdwn.Escape(p.inline(n.text, true)))
+	case mdCode:
+		return mrkdwn.CodeBlock(mrkdwn.Escape(strings.TrimSuffix(n.text, "\n")))
+	case mdQuote:
+		return mrkdwn.Quote(p.render(n.children, "\n"))
+	case mdList:
+		items := make([]string, len(n.items))
+		for i, item := range n.items {
+			marker := "• "
+			if n.ordered {
+				marker = strconv.Itoa(n.start+i) + ". "
+			}
+			items[i] = marker + strings.ReplaceAll(p.render(item, "\n"), "\n", "\n    ")
+		}
+		return strings.Join(items, "\n")
+	case mdRule:
+		return mdRuleText
+	case mdTable:
+		return mrkdwn.CodeBlock(mrkdwn.Escape(p.table(n)))
+	}
+
+	return p.inline(n.text, false)
+}
+
+// table renders the table n as aligned plain text.
+func (p *markdown) table(n *mdNode) string {
+	rows := make([][]string, len(n.rows))
+	widths := make([]int, len(n.align))
+	for i, row := range n.rows {
+		rows[i] = make([]string, len(row))
+		for j, c := range row {
+			rows[i][j] = p.inline(c, true)
+			if w := utf8.RuneCountInString(rows[i][j]); w > widths[j] {
+				widths[j] = w
+			}
+		}
+	}
+
+	var b strings.Builder
+	for i, row := range rows {
+		if i == 1 {
+			for j, w := range widths {
+				if j > 0 {
+					b.WriteString("-+-")
+				}
+				b.WriteString(strings.Repeat("-", w))
+			}
+			b.WriteString("\n")
+		}
+
+		var line strings.Builder
+		for j, c := range row {
+			if j > 0 {
+				line.WriteString(" | ")
+			}
+			pad := widths[j] - utf8.RuneCountInString(c)
+			switch n.align[j] {
+			case 'r':
+				fmt.Fprintf(&line, "%s%s", strings.Repeat(" ", pad), c)
+			case 'c':
+				fmt.Fprintf(&line, "%s%s%s", strings.Repeat(" ", pad/2), c, strings.Repeat(" ", pad-pad/2))
+			default:
+				fmt.Fprintf(&line, "%s%s", c, strings.Repeat(" ", pad))
+			}
+		}
+		b.WriteString(strings.TrimRight(line.String(), " "))
+		if i < len(rows)-1 {
+			b.WriteString("\n")
+		}
+	}
+
+	return b.String()
+}
+
+// zeroWidthSpace separates escaped markers from the text around them.
+const zeroWidthSpace = "\u200b"
+
+var (
+	reMDAutolink = regexp.MustCompile(`^<([A-Za-z][A-Za-z0-9+.-]{1,31}:[^ <>]*)>`)
+	reMDEmail    = regexp.MustCompile(`^<([^ @<>]+@[^ @<>]+\.[^ @<>]+)>`)
+	reMDEntity   = regexp.MustCompile(`^&(?:#[0-9]{1,7}|#[xX][0-9a-fA-F]{1,6}|[A-Za-z][A-Za-z0-9]{1,31});`)
+)
+
+// inline renders the inline Markdown s as mrkdwn, or if plain is true as plain text.
+func (p *markdown) inline(s string, plain bool) string {
+	var out []byte
+	text := func(t string) {
+		if !plain {
+			t = mrkdwn.Escape(t)
+		}
+		out = append(out, t...)
+	}
+
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == '\\' && i+1 < len(s) && s[i+1] == '\n':
+			out = append(out, '\n')
+			i += 2
+		case c == '\\' && i+1 < len(s) && isPunct(s[i+1]):
+			if !plain && strings.IndexByte("*_~`", s[i+1]) >= 0 {
+				// Surround escaped markers with zero width spaces so slack
+				// doesn't pair them with other markers as formatting.
+				out = append(out, zeroWidthSpace+s[i+1:i+2]+zeroWidthSpace...)
+			} else {
+				text(s[i+1 : i+2])
+			}
+			i += 2
+		case c == '\n':
+			// Two or more trailing spaces make a hard line break.
+			hard := strings.HasSuffix(s[:i], "  ")
+			for len(out) > 0 && out[len(out)-1] == ' ' {
+				out = out[:len(out)-1]
+			}
+			if hard {
+				out = append(out, '\n')
+			} else {
+				out = append(out, ' ')
+			}
+			i++
+		case c == '`':
+			n := runLen(s, i)
+			end := closingCode(s, i+n, n)
+			if end < 0 {
+				text(s[i : i+n])
+				i += n
+				break
+			}
+
+			code := strings.ReplaceAll(s[i+n:end], "\n", " ")
+			if len(code) > 2 && code[0] == ' ' && code[len(code)-1] == ' ' && strings.TrimSpace(code) != "" {
+				code = code[1 : len(code)-1]
+			}
+			if plain {
+				out = append(out, code...)
+			} else {
+				out = append(out, mrkdwn.Code(mrkdwn.Escape(code))...)
+			}
+			i = end + n
+		case c == '!' && i+1 < len(s) && s[i+1] == '[':
+			label, url, end, ok := p.link(s, i+1)
+			if !ok {
+				text("!")
+				i++
+				break
+			}
+			out = append(out, p.linkText(label, url, plain)...)
+			i = end
+		case c == '[':
+			label, url, end, ok := p.link(s, i)
+			if !ok {
+				text("[")
+				i++
+				break
+			}
+			out = append(out, p.linkText(label, url, plain)...)
+			i = end
+		case c == '<':
+			if m := reMDAutolink.FindStringSubmatch(s[i:]); m != nil {
+				if plain {
+					out = append(out, m[1]...)
+				} else {
+					out = append(out, mrkdwn.Link(m[1], "")...)
+				}
+				i += len(m[0])
+			} else if m := reMDEmail.FindStringSubmatch(s[i:]); m != nil {
+				if plain {
+					out = append(out, m[1]...)
+				} else {
+					out = append(out, mrkdwn.Link("mailto:"+m[1], m[1])...)
+				}
+				i += len(m[0])
+			} else {
+				text("<")
+				i++
+			}
+		case c == '&':
+			if m := reMDEntity.FindString(s[i:]); m != "" {
+				text(html.UnescapeString(m))
+				i += len(m)
+			} else {
+				text("&")
+				i++
+			}
+		case c == '*' || c == '_' || c == '~':
+			n := runLen(s, i)
+			if inner, end, strong, ok := delimited(s, i, n); ok {
+				inner := p.inline(inner, plain)
+				switch {
+				case plain:
+					out = append(out, inner...)
+				case c == '~':
+					out = append(out, mrkdwn.Strike(inner)...)
+				case strong:
+					out = append(out, mrkdwn.Bold(inner)...)
+				default:
+					out = append(out, mrkdwn.Italic(inner)...)
+				}
+				i = end
+				break
+			}
+			text(s[i : i+n])
+			i += n
+		default:
+			text(s[i : i+1])
+			i++
+		}
+	}
+
+	return string(out)
+}
+
+// linkText renders a link to url with the inline Markdown label.
+func (p *markdown) linkText(label, url string, plain bool) string {
+	label = p.inline(label, true)
+	if plain {
+		return label
+	}
+
+	return mrkdwn.Link(url, label)
+}
+
+// link parses the link or reference link starting with the '[' at s[i]
+// returning its label, url and the end of the link.
+func (p *markdown) link(s string, i int) (label, url string, end int, ok bool) {
+	close := closingBracket(s, i)
+	if close < 0 {
+		return "", "", 0, false
+	}
+	label, end = s[i+1:close], close+1
+
+	// Inline link [label](url "title").
+	if end < len(s) && s[end] == '(' {
+		if dest, e, ok := linkDest(s, end+1); ok {
+			return label, dest, e, true
+		}
+	}
+
+	// Full [label][ref], collapsed [label][] and shortcut [label] reference links.
+	ref := label
+	if end < len(s) && s[end] == '[' {
+		if j := strings.IndexByte(s[end:], ']'); j >= 0 {
+			if r := s[end+1 : end+j]; r != "" {
+				ref = r
+			}
+			end += j + 1
+		}
+	}
+	if url, ok := p.refs[strings.ToLower(ref)]; ok {
+		return label, url, end, true
+	}
+
+	return "", "", 0, false
+}
+
+// linkDest parses the destination and optional title of an inline link
+// starting after the '(' at s[i-1], returning the destination and the end
+// of the link. Destinations in angle brackets may contain spaces, which are
+// percent encoded.
+func linkDest(s string, i int) (dest string, end int, ok bool) {
+	for i < len(s) && isSpace(s[i]) {
+		i++
+	}
+
+	rest := i
+	if i < len(s) && s[i] == '<' {
+		j := strings.IndexAny(s[i+1:], "<>\n")
+		if j < 0 || s[i+1+j] != '>' {
+			return "", 0, false
+		}
+		dest = strings.ReplaceAll(s[i+1:i+1+j], " ", "%20")
+		rest = i + j + 2
+	} else {
+		j := strings.IndexAny(s[i:], " \t\n)")
+		if j < 0 {
+			return "", 0, false
+		}
+		dest = s[i : i+j]
+		rest = i + j
+	}
+
+	j := strings.IndexByte(s[rest:], ')')
+	if j < 0 {
+		return "", 0, false
+	}
+
+	return dest, rest + j + 1, true
+}
+
+// closingBracket returns the index of the ']' matching the '[' at s[i] or -1 if there is none.
+func closingBracket(s string, i int) int {
+	depth := 0
+	for j := i; j < len(s); j++ {
+		switch s[j] {
+		case '\\':
+			j++
+		case '[':
+			depth++
+		case ']':
+			if depth--; depth == 0 {
+				return j
+			}
+		}
+	}
+
+	return -1
+}
+
+// closingCode returns the index of the run of exactly n backticks which
+// closes a code span opened before s[i] or -1 if there is none.
+func closingCode(s string, i, n int) int {
+	for i < len(s) {
+		j := strings.IndexByte(s[i:], '`')
+		if j < 0 {
+			return -1
+		}
+		i += j
+		if l := runLen(s, i); l != n {
+			i += l
+			continue
+		}
+
+		return i
+	}
+
+	return -1
+}
+
+// delimited returns the content of the emphasis or strikethrough started by the
+// run of n delimiters at s[i], the end of its closing delimiter and if it's strong.
+func delimited(s string, i, n int) (inner string, end int, strong, ok bool) {
+	c := s[i]
+	next := i + n
+	if next >= len(s) || isSpace(s[next]) {
+		return "", 0, false, false
+	}
+	if c == '_' && i > 0 && isAlnum(s[i-1]) {
+		// Intraword underscores such as in snake_case aren't emphasis.
+		return "", 0, false, false
+	}
+
+	want := 1
+	if n >= 2 {
+		want = 2
+	}
+	if c == '~' && n > 2 {
+		return "", 0, false, false
+	}
+
+	for want > 0 {
+		for j := next; j < len(s); {
+			switch {
+			case s[j] == '\\':
+				j += 2
+				continue
+			case s[j] == '`':
+				l := runLen(s, j)
+				if e := closingCode(s, j+l, l); e >= 0 {
+					j = e + l
+				} else {
+					j += l
+				}
+				continue
+			case s[j] != c:
+				j++
+				continue
+			}
+
+			l := runLen(s, j)
+			closes := !isSpace(s[j-1]) && (c != '_' || j+l >= len(s) || !isAlnum(s[j+l]))
+			if closes && (l == want || l >= 3 || (c == '~' && l == n)) {
+				if c == '~' {
+					return s[next:j], j + l, false, true
+				}
+				return s[i+want : j+l-want], j + l, want == 2, true
+			}
+			j += l
+		}
+
+		if c == '~' {
+			break
+		}
+		want--
+	}
+
+	return "", 0, false, false
+}
+
+// runLen returns the length of the run of the byte at s[i].
+func runLen(s string, i int) int {
+	n := 1
+	for i+n < len(s) && s[i+n] == s[i] {
+		n++
+	}
+
+	return n
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n'
+}
+
+func isAlnum(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= 0x80
+}
+
+func isPunct(c byte) bool {
+	return strings.IndexByte("!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~", c) >= 0
+}
@@ -0,0 +1,160 @@
+package chat
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarkdownToMrkdwnInline(t *testing.T) {
+	tests := []struct {
+		name     string
+		md       string
+		expected string
+	}{
+		{"text", "plain text", "plain text"},
+		{"escape", "a < b && c > d", "a &lt; b &amp;&amp; c &gt; d"},
+		{"entity", "&copy; &amp; &#35;", "© &amp; #"},
+		{"bold", "**bold** and __bold__", "*bold* and *bold*"},
+		{"italic", "*italic* and _italic_", "_italic_ and _italic_"},
+		{"bold-italic", "***both***", "*_both_*"},
+		{"nested", "*a **b** c*", "_a *b* c_"},
+		{"strike", "~~strike~~ and ~one~", "~strike~ and ~one~"},
+		{"intraword", "snake_case_name", "snake_case_name"},
+		{"unclosed", "2 * 3 and **open", "2 * 3 and **open"},
+		{"code", "run `go test` now", "run `go test` now"},
+		{"code-escape", "`a<b>`", "`a&lt;b&gt;`"},
+		{"code-ticks", "`` a`b ``", "`a`b`"},
+		{"code-emphasis", "`*not*`", "`*not*`"},
+		{"unclosed-code", "a ` b", "a ` b"},
+		{"link", "[Go](https://go.dev)", "<https://go.dev|Go>"},
+		{"link-title", `[Go](https://go.dev "The Go site")`, "<https://go.dev|Go>"},
+		{"link-formatted", "[**Go** site](https://go.dev)", "<https://go.dev|Go site>"},
+		{"image", "![logo](https://go.dev/logo.png)", "<https://go.dev/logo.png|logo>"},
+		{"autolink", "<https://go.dev>", "<https://go.dev>"},
+		{"email", "<gopher@go.dev>", "<mailto:gopher@go.dev|gopher@go.dev>"},
+		{"not-link", "[not a link] here", "[not a link] here"},
+		{"escaped", `\*not bold\* \[x\]`, "\u200b*\u200bnot bold\u200b*\u200b [x]"},
+		{"escaped-underscore", `\_not italic\_`, "\u200b_\u200bnot italic\u200b_\u200b"},
+		{"escaped-strike", `\~not struck\~`, "\u200b~\u200bnot struck\u200b~\u200b"},
+		{"link-angle", "[a](<b c>)", "<b%20c|a>"},
+		{"link-angle-title", `[a](<https://go.dev/a b> "title")`, "<https://go.dev/a%20b|a>"},
+		{"link-angle-unclosed", "[a](<b c)", "[a](&lt;b c)"},
+		{"soft-break", "line one\nline two", "line one line two"},
+		{"hard-break", "line one  \nline two\\\nline three", "line one\nline two\nline three"},
+		{"unicode", "**héllo** wörld", "*héllo* wörld"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, MarkdownToMrkdwn(tc.md))
+		})
+	}
+}
+
+func TestMarkdownToMrkdwnBlocks(t *testing.T) {
+	tests := []struct {
+		name     string
+		md       string
+		expected string
+	}{
+		{"empty", "", ""},
+		{"paragraphs", "one\n\n\ntwo", "one\n\ntwo"},
+		{"atx", "# Title #\n## Sub *title*", "*Title*\n\n*Sub title*"},
+		{"atx-escape", "# a < b", "*a &lt; b*"},
+		{"not-heading", "#hashtag", "#hashtag"},
+		{"setext", "Title\n=====\n\nSub\n---", "*Title*\n\n*Sub*"},
+		{"rule", "a\n\n***\n\nb", "a\n\n" + mdRuleText + "\n\nb"},
+		{"fence", "```go\nfunc main() {\n\tif a < b {}\n}\n```", "```\nfunc main() {\n    if a &lt; b {}\n}\n```"},
+		{"fence-tilde", "~~~\n```\n~~~", "```\n```\n```"},
+		{"fence-unclosed", "```\ncode", "```\ncode\n```"},
+		{"indented-code", "para\n\n    code\n      more", "para\n\n```\ncode\n  more\n```"},
+		{"quote", "> quoted *text*\nlazy\n> > nested", "> quoted _text_ lazy\n> > nested"},
+		{"bullets", "- one\n- two\n  continued\n* three", "• one\n• two continued\n\n• three"},
+		{"ordered", "3. three\n4. four", "3. three\n4. four"},
+		{"nested-list", "- one\n  - nested\n- two", "• one\n    • nested\n• two"},
+		{"list-code", "1. step\n\n   ```\n   make\n   ```", "1. step\n    ```\n    make\n    ```"},
+		{"list-rule", "- a\n- - -", "• a\n\n" + mdRuleText},
+		{"ref", "[Go][go] and [go]\n\n[go]: https://go.dev \"Go\"", "<https://go.dev|Go> and <https://go.dev|go>"},
+		{
+			"table",
+			"| Name | Count | Status |\n|:-----|------:|:------:|\n| `api` | 1 | **ok** |\n| web \\| ui | 20 |",
+			"```\nName     | Count | Status\n---------+-------+-------\napi      |     1 |   ok\nweb | ui |    20 |\n```",
+		},
+		{"not-table", "a | b\nc | d", "a | b c | d"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, MarkdownToMrkdwn(tc.md))
+		})
+	}
+}
+
+func TestMarkdownToBlocks(t *testing.T) {
+	md := `# Release v1.2.0
+
+Highlights of this **release**:
+
+- faster builds
+- fewer bugs
+
+---
+
+` + "```" + `
+make release
+` + "```" + `
+
+| a | b |
+|---|---|
+| 1 | 2 |
+`
+
+	blocks := MarkdownToBlocks(md)
+	expected := Blocks{
+		&HeaderBlock{Text: NewPlainText("Release v1.2.0")},
+		&SectionBlock{Text: NewMarkdownText("Highlights of this *release*:\n\n• faster builds\n• fewer bugs")},
+		&DividerBlock{},
+		&RichTextBlock{Elements: RichTextElements{
+			&RichTextPreformatted{Elements: RichTextInlines{&RichTextText{Text: "make release"}}},
+		}},
+		&RichTextBlock{Elements: RichTextElements{
+			&RichTextPreformatted{Elements: RichTextInlines{&RichTextText{Text: "a | b\n--+--\n1 | 2"}}},
+		}},
+	}
+	assert.Equal(t, expected, blocks)
+	assert.NoError(t, (&Message{Blocks: blocks}).Validate())
+}
+
+func TestMarkdownToBlocksLimits(t *testing.T) {
+	long := make([]byte, MaxSectionTextLen*2)
+	for i := range long {
+		long[i] = 'a'
+	}
+
+	blocks := MarkdownToBlocks("# " + string(long) + "\n\n" + string(long) + "\n\n```\n```")
+	if assert.Len(t, blocks, 3) {
+		assert.Len(t, blocks[0].(*HeaderBlock).Text.Text, MaxHeaderTextLen+len(DefaultEllipsis)-1)
+	}
+	assert.NoError(t, (&Message{Blocks: blocks}).Validate())
+}
+
+func TestMarkdownEscapedMarkers(t *testing.T) {
+	// Each escaped marker gains a zero width space either side, which count
+	// towards the limits.
+	s := MarkdownToMrkdwn(`\*`)
+	assert.Equal(t, "\u200b*\u200b", s)
+	assert.Equal(t, 3, utf8.RuneCountInString(s))
+
+	blocks := MarkdownToBlocks(strings.Repeat(`\*`, MaxSectionTextLen))
+	if assert.Len(t, blocks, 3) {
+		var n int
+		for _, b := range blocks {
+			n += utf8.RuneCountInString(b.(*SectionBlock).Text.Text)
+		}
+		assert.Equal(t, MaxSectionTextLen*3, n)
+	}
+	assert.NoError(t, (&Message{Blocks: blocks}).Validate())
+}
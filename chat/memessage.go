@@ -0,0 +1,41 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/multiplay/go-slack"
+)
+
+const (
+	// MeMessageEndpoint is the slack URL endpoint for chat me message.
+	MeMessageEndpoint = "https://slack.com/api/chat.meMessage"
+)
+
+// MeMessage is a /me message, which is displayed in italics as an action
+// performed by the authed user.
+// Posting /me messages requires a token based client such as the api client.
+//
+// See: https://api.slack.com/methods/chat.meMessage
+type MeMessage struct {
+	// Channel is the channel to send the message to.
+	Channel string `json:"channel"`
+
+	// Text of the message to send.
+	Text string `json:"text"`
+}
+
+// Send sends the me message to slack using the client c.
+func (m *MeMessage) Send(c slack.Client) (*MessageResponse, error) {
+	return m.SendContext(context.Background(), c)
+}
+
+// SendContext sends the me message to slack using the client c and ctx to
+// control the lifetime of the request.
+func (m *MeMessage) SendContext(ctx context.Context, c slack.Client) (*MessageResponse, error) {
+	resp := &MessageResponse{}
+	if err := slack.NewContextClient(c).SendContext(ctx, MeMessageEndpoint, m, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
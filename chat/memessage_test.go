@@ -0,0 +1,33 @@
+package chat
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiplay/go-slack"
+	"github.com/multiplay/go-slack/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMeMessageSend(t *testing.T) {
+	m := &MeMessage{Channel: "C123", Text: "is deploying"}
+	resp, err := m.Send(test.NewAPI())
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "C123", resp.Channel)
+	assert.NotEmpty(t, resp.Timestamp)
+
+	r, ok := test.Server().LastRequest()
+	if assert.True(t, ok) {
+		assert.Equal(t, "chat.meMessage", r.Method)
+		assert.Equal(t, map[string]interface{}{"channel": "C123", "text": "is deploying"}, r.Values())
+	}
+}
+
+func TestMeMessageSendError(t *testing.T) {
+	m := &MeMessage{Channel: "C123"}
+	_, err := m.Send(test.NewAPI())
+	assert.True(t, errors.Is(err, slack.ErrNoText))
+}
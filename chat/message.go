@@ -4,6 +4,9 @@
 package chat
 
 import (
+	"context"
+	"encoding/json"
+
 	"github.com/multiplay/go-slack"
 )
 
@@ -35,6 +38,9 @@ type Message struct {
 	// Attachments is structured message attachments
 	Attachments []*Attachment `json:"attachments,omitempty"`
 
+	// Blocks are the Block Kit layout blocks of the message.
+	Blocks Blocks `json:"blocks,omitempty"`
+
 	// UnfurLinks enables unfurling of primarily text-based content.
 	UnfurlLinks bool `json:"unfurl_links,omitempty"`
 
@@ -46,7 +52,7 @@ type Message struct {
 	Username string `json:"username,omitempty"`
 
 	// AsUser pass true to post the message as the authed user, instead of as a bot.
-	AsUser bool `json:"as_user"`
+	AsUser bool `json:"as_user,omitempty"`
 
 	// IconURL is the URL to an image to use as the icon for this message.
 	// Must be used in conjunction with AsUser set to false, otherwise ignored.
@@ -63,6 +69,33 @@ type Message struct {
 	// ReplyBroadcast used in conjunction with thread_ts and indicates whether reply
 	// should be made visible to everyone in the channel or conversation.
 	ReplyBroadcast bool `json:"reply_broadcast,omitempty"`
+
+	// Metadata is optional structured data attached to the message.
+	// Use SetMetadata to set it from a Go value and Payload to decode it.
+	Metadata *Metadata `json:"metadata,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which aren't otherwise
+	// encoded, such as fields which Message doesn't model. They're included
+	// when the message is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (m Message) MarshalJSON() ([]byte, error) {
+	type alias Message
+	return marshalExtra(alias(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (m *Message) UnmarshalJSON(data []byte) error {
+	type alias Message
+	extra, err := unmarshalExtra(data, (*alias)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+
+	return nil
 }
 
 // NewAttachment creates a new empty attachment adds it to the message and returns it.
@@ -78,10 +111,22 @@ func (m *Message) AddAttachment(a *Attachment) {
 	m.Attachments = append(m.Attachments, a)
 }
 
+// AddBlock adds b to the message's blocks.
+func (m *Message) AddBlock(b Block) {
+	m.Blocks = append(m.Blocks, b)
+}
+
 // Send sends the msg to slack using the client c.
 func (m *Message) Send(c slack.Client) (*MessageResponse, error) {
+	return m.SendContext(context.Background(), c)
+}
+
+// SendContext sends the msg to slack using the client c and ctx to control
+// the lifetime of the request.
+// If c doesn't implement slack.ContextClient it's adapted using slack.NewContextClient.
+func (m *Message) SendContext(ctx context.Context, c slack.Client) (*MessageResponse, error) {
 	resp := &MessageResponse{}
-	if err := c.Send(PostMessageEndpoint, m, resp); err != nil {
+	if err := slack.NewContextClient(c).SendContext(ctx, PostMessageEndpoint, m, resp); err != nil {
 		return nil, err
 	}
 
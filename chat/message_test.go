@@ -1,6 +1,8 @@
 package chat
 
 import (
+	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/multiplay/go-slack/test"
@@ -18,6 +20,119 @@ func TestMessageNewAttachment(t *testing.T) {
 	assert.Equal(t, a, m.Attachments[0])
 }
 
+func TestMessageAddBlock(t *testing.T) {
+	m := &Message{}
+	b := &DividerBlock{}
+	m.AddBlock(b)
+
+	if !assert.Equal(t, 1, len(m.Blocks)) {
+		return
+	}
+	assert.Equal(t, b, m.Blocks[0])
+}
+
+func TestMessageBlocksJSON(t *testing.T) {
+	m := &Message{Channel: "C123", Blocks: testBlocks}
+	b, err := json.Marshal(m)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.JSONEq(t, `{"channel":"C123","blocks":`+testBlocksJSON+`}`, string(b))
+
+	m2 := &Message{}
+	if assert.NoError(t, json.Unmarshal(b, m2)) {
+		assert.Equal(t, m, m2)
+	}
+}
+
+func TestMessageRoundTrip(t *testing.T) {
+	// A message as returned by slack including fields and types which aren't modelled.
+	data := `{
+		"type":"message",
+		"subtype":"bot_message",
+		"text":"deployed",
+		"mrkdwn":false,
+		"ts":"1503435956.000247",
+		"bot_id":"B123",
+		"bot_profile":{"id":"B123","name":"deploy"},
+		"metadata":{"event_type":"deploy","event_payload":{"id":1}},
+		"attachments":[{
+			"id":1,
+			"fallback":"fallback",
+			"color":"good",
+			"thumb_url":"https://example.com/thumb.png",
+			"fields":[{"title":"Env","value":"prod","short":true,"extra":1}]
+		}],
+		"blocks":[
+			{"type":"section","block_id":"b1","text":{"type":"mrkdwn","text":"deployed"}},
+			{"type":"video","title":{"type":"plain_text","text":"demo"},"video_url":"https://example.com/v"},
+			{"type":"rich_text","block_id":"b2","elements":[
+				{"type":"rich_text_section","elements":[{"type":"text","text":"hi"},{"type":"new_inline"}]}
+			]}
+		]
+	}`
+
+	m := &Message{}
+	if !assert.NoError(t, json.Unmarshal([]byte(data), m)) {
+		return
+	}
+	assert.Equal(t, "deployed", m.Text)
+	assert.Contains(t, m.Extra, "bot_profile")
+	assert.Contains(t, m.Extra, "mrkdwn")
+	if assert.Len(t, m.Attachments, 1) {
+		assert.Contains(t, m.Attachments[0].Extra, "thumb_url")
+		assert.Contains(t, m.Attachments[0].Extra, "id")
+	}
+
+	b, err := json.Marshal(m)
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, data, string(b))
+	}
+
+	// Modelled fields take precedence over Extra.
+	m.Markdown = true
+	b, err = json.Marshal(m)
+	if assert.NoError(t, err) {
+		assert.Contains(t, string(b), `"mrkdwn":true`)
+		assert.NotContains(t, string(b), `"mrkdwn":false`)
+	}
+}
+
+func TestMessageRoundTripUnset(t *testing.T) {
+	// Fields which aren't set aren't added when the message is encoded.
+	data := `{"type":"message","text":"hi","attachments":[{"title":"t","thumb_url":"x"}]}`
+	m := &Message{}
+	if !assert.NoError(t, json.Unmarshal([]byte(data), m)) {
+		return
+	}
+
+	b, err := json.Marshal(m)
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, data, string(b))
+	}
+}
+
+func TestMessageUnmarshalError(t *testing.T) {
+	m := &Message{}
+	assert.Error(t, json.Unmarshal([]byte(`{"text":1}`), m))
+	assert.Error(t, json.Unmarshal([]byte(`{"blocks":[{"type":"section","text":1}]}`), m))
+	assert.Error(t, json.Unmarshal([]byte(`{"attachments":[{"text":1}]}`), m))
+	assert.Error(t, json.Unmarshal([]byte(`{"attachments":[{"fields":[{"title":1}]}]}`), m))
+}
+
+func TestMessageResponseRoundTrip(t *testing.T) {
+	data := `{"ok":true,"channel":"C123","ts":"1503435956.000247","message":{"type":"message","text":"hi","bot_id":"B123"}}`
+	r := &MessageResponse{}
+	if !assert.NoError(t, json.Unmarshal([]byte(data), r)) {
+		return
+	}
+
+	b, err := json.Marshal(r)
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, data, string(b))
+	}
+}
+
 func TestMessageSend(t *testing.T) {
 	c := test.New()
 	m := &Message{Text: "test message"}
@@ -35,6 +150,26 @@ func TestMessageSendError(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestMessageSendContext(t *testing.T) {
+	c := test.New()
+	m := &Message{Text: "test message"}
+	resp, err := m.SendContext(context.Background(), c)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.True(t, resp.OK)
+}
+
+func TestMessageSendContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	c := test.New()
+	m := &Message{Text: "test message"}
+	_, err := m.SendContext(ctx, c)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
 func TestAddAttachment(t *testing.T) {
 	m := &Message{}
 	a := &Attachment{}
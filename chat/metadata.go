@@ -0,0 +1,73 @@
+package chat
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Metadata is structured data attached to a message, which other apps can
+// read in addition to the human readable content of the message.
+//
+// See: https://api.slack.com/metadata
+type Metadata struct {
+	// EventType is the type of the event the metadata describes e.g. deploy_finished.
+	EventType string `json:"event_type"`
+
+	// EventPayload is the JSON object describing the event.
+	EventPayload json.RawMessage `json:"event_payload"`
+}
+
+// NewMetadata returns metadata of eventType with payload, which must
+// encode to a JSON object, as its event payload.
+func NewMetadata[T any](eventType string, payload T) (*Metadata, error) {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	if !isObject(b) {
+		return nil, fmt.Errorf("chat: metadata payload %T isn't a JSON object", payload)
+	}
+
+	return &Metadata{EventType: eventType, EventPayload: b}, nil
+}
+
+// Payload decodes the event payload of md into a T. It returns false if md
+// is nil or its event type isn't eventType.
+func Payload[T any](md *Metadata, eventType string) (T, bool, error) {
+	var v T
+	if md == nil || md.EventType != eventType {
+		return v, false, nil
+	}
+	if err := json.Unmarshal(md.EventPayload, &v); err != nil {
+		return v, false, err
+	}
+
+	return v, true, nil
+}
+
+// SetMetadata sets the metadata of m to eventType with payload, which must
+// encode to a JSON object, as its event payload.
+func (m *Message) SetMetadata(eventType string, payload interface{}) error {
+	md, err := NewMetadata(eventType, payload)
+	if err != nil {
+		return err
+	}
+	m.Metadata = md
+
+	return nil
+}
+
+// Metadata returns the metadata of the message r was returned for or nil if it has none.
+func (r *MessageResponse) Metadata() *Metadata {
+	if r.Message == nil {
+		return nil
+	}
+
+	return r.Message.Metadata
+}
+
+// isObject returns true if data is a JSON object.
+func isObject(data []byte) bool {
+	return bytes.HasPrefix(bytes.TrimSpace(data), []byte("{"))
+}
@@ -0,0 +1,124 @@
+package chat
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/multiplay/go-slack/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type deploy struct {
+	BuildID int    `json:"build_id"`
+	Commit  string `json:"commit"`
+}
+
+func TestNewMetadata(t *testing.T) {
+	md, err := NewMetadata("deploy_finished", deploy{BuildID: 42, Commit: "abc123"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "deploy_finished", md.EventType)
+	assert.JSONEq(t, `{"build_id":42,"commit":"abc123"}`, string(md.EventPayload))
+
+	md, err = NewMetadata("counts", map[string]int{"a": 1})
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, `{"a":1}`, string(md.EventPayload))
+	}
+
+	_, err = NewMetadata("invalid", []int{1})
+	assert.Error(t, err)
+
+	_, err = NewMetadata("invalid", func() {})
+	assert.Error(t, err)
+}
+
+func TestPayload(t *testing.T) {
+	m := &Message{Text: "deployed"}
+	if !assert.NoError(t, m.SetMetadata("deploy_finished", &deploy{BuildID: 42, Commit: "abc123"})) {
+		return
+	}
+
+	d, ok, err := Payload[deploy](m.Metadata, "deploy_finished")
+	if assert.NoError(t, err) && assert.True(t, ok) {
+		assert.Equal(t, deploy{BuildID: 42, Commit: "abc123"}, d)
+	}
+
+	_, ok, err = Payload[deploy](m.Metadata, "deploy_started")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	_, ok, err = Payload[deploy](nil, "deploy_finished")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	_, ok, err = Payload[int](m.Metadata, "deploy_finished")
+	assert.Error(t, err)
+	assert.False(t, ok)
+
+	assert.Error(t, m.SetMetadata("invalid", "string"))
+}
+
+func TestMessageMetadataJSON(t *testing.T) {
+	m := &Message{Channel: "C123", Text: "deployed"}
+	if !assert.NoError(t, m.SetMetadata("deploy_finished", deploy{BuildID: 42})) {
+		return
+	}
+
+	b, err := json.Marshal(m)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.JSONEq(t, `{"channel":"C123","text":"deployed","metadata":{"event_type":"deploy_finished","event_payload":{"build_id":42,"commit":""}}}`, string(b))
+
+	m2 := &Message{}
+	if assert.NoError(t, json.Unmarshal(b, m2)) && assert.NotNil(t, m2.Metadata) {
+		assert.Equal(t, "deploy_finished", m2.Metadata.EventType)
+		assert.Nil(t, m2.Extra)
+	}
+}
+
+func TestMessageResponseMetadata(t *testing.T) {
+	c := test.NewAPI()
+	m := &Message{Channel: "C123", Text: "deployed"}
+	if !assert.NoError(t, m.SetMetadata("deploy_finished", deploy{BuildID: 42, Commit: "abc123"})) {
+		return
+	}
+
+	resp, err := m.Send(c)
+	if !assert.NoError(t, err) {
+		return
+	}
+	d, ok, err := Payload[deploy](resp.Metadata(), "deploy_finished")
+	if assert.NoError(t, err) && assert.True(t, ok) {
+		assert.Equal(t, 42, d.BuildID)
+	}
+	assert.Nil(t, (&MessageResponse{}).Metadata())
+
+	// Metadata is only returned by conversations.replies when requested.
+	msgs, err := (&Replies{Channel: resp.Channel, Timestamp: resp.Timestamp}).All(c)
+	if assert.NoError(t, err) && assert.Len(t, msgs, 1) {
+		assert.Nil(t, msgs[0].Metadata)
+	}
+	msgs, err = resp.Thread(c).Replies()
+	if assert.NoError(t, err) && assert.Len(t, msgs, 1) {
+		d, ok, err = Payload[deploy](msgs[0].Metadata, "deploy_finished")
+		if assert.NoError(t, err) && assert.True(t, ok) {
+			assert.Equal(t, "abc123", d.Commit)
+		}
+	}
+}
+
+func ExamplePayload() {
+	m := &Message{Channel: "#deploys", Text: "Build 42 deployed"}
+	if err := m.SetMetadata("deploy_finished", deploy{BuildID: 42, Commit: "abc123"}); err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+
+	d, ok, err := Payload[deploy](m.Metadata, "deploy_finished")
+	fmt.Println(d.BuildID, d.Commit, ok, err)
+	// Output: 42 abc123 true <nil>
+}
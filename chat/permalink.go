@@ -0,0 +1,71 @@
+package chat
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/multiplay/go-slack"
+)
+
+const (
+	// GetPermalinkEndpoint is the slack URL endpoint for chat get permalink.
+	GetPermalinkEndpoint = "https://slack.com/api/chat.getPermalink"
+)
+
+// Permalink is a request for the permanent URL of a message.
+// Getting permalinks requires a token based client such as the api client.
+//
+// See: https://api.slack.com/methods/chat.getPermalink
+type Permalink struct {
+	// Channel is the ID of the channel containing the message.
+	Channel string
+
+	// MessageTimestamp is the timestamp (ts) of the message.
+	MessageTimestamp string
+}
+
+// values returns the form arguments of the request, as chat.getPermalink
+// doesn't accept JSON.
+func (p *Permalink) values() url.Values {
+	return url.Values{"channel": {p.Channel}, "message_ts": {p.MessageTimestamp}}
+}
+
+// Send sends the permalink request to slack using the client c.
+func (p *Permalink) Send(c slack.Client) (*PermalinkResponse, error) {
+	return p.SendContext(context.Background(), c)
+}
+
+// SendContext sends the permalink request to slack using the client c and
+// ctx to control the lifetime of the request.
+func (p *Permalink) SendContext(ctx context.Context, c slack.Client) (*PermalinkResponse, error) {
+	resp := &PermalinkResponse{}
+	if err := slack.NewContextClient(c).SendContext(ctx, GetPermalinkEndpoint, p.values(), resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// PermalinkResponse the response returned from the get permalink call.
+type PermalinkResponse struct {
+	slack.Response
+	Channel   string `json:"channel,omitempty"`
+	Permalink string `json:"permalink,omitempty"`
+}
+
+// Permalink returns the permanent URL of the message r was returned for using the client c.
+func (r *MessageResponse) Permalink(c slack.Client) (string, error) {
+	return r.PermalinkContext(context.Background(), c)
+}
+
+// PermalinkContext returns the permanent URL of the message r was returned
+// for using the client c and ctx to control the lifetime of the request.
+func (r *MessageResponse) PermalinkContext(ctx context.Context, c slack.Client) (string, error) {
+	p := &Permalink{Channel: r.Channel, MessageTimestamp: r.Timestamp}
+	resp, err := p.SendContext(ctx, c)
+	if err != nil {
+		return "", err
+	}
+
+	return resp.Permalink, nil
+}
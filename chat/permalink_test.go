@@ -0,0 +1,38 @@
+package chat
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiplay/go-slack"
+	"github.com/multiplay/go-slack/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMessageResponsePermalink(t *testing.T) {
+	c := test.NewAPI()
+	resp, err := (&Message{Channel: "C123", Text: "incident started"}).Send(c)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	link, err := resp.Permalink(c)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Contains(t, link, "/archives/C123/p")
+
+	r, ok := test.Server().LastRequest()
+	if assert.True(t, ok) {
+		assert.Equal(t, "chat.getPermalink", r.Method)
+		assert.Equal(t, "application/x-www-form-urlencoded", r.Header.Get("Content-Type"))
+		assert.Equal(t, map[string]interface{}{"channel": "C123", "message_ts": resp.Timestamp}, r.Values())
+	}
+}
+
+func TestPermalinkSendError(t *testing.T) {
+	p := &Permalink{Channel: "C123", MessageTimestamp: "1.000000"}
+	_, err := p.Send(test.NewAPI())
+	assert.True(t, errors.Is(err, slack.ErrMessageNotFound))
+}
@@ -0,0 +1,584 @@
+package chat
+
+import (
+	"encoding/json"
+)
+
+// richTextTypes maps rich text element types to a function which creates an element of that type.
+var richTextTypes = map[string]func() RichTextElement{
+	"rich_text_section":      func() RichTextElement { return &RichTextSection{} },
+	"rich_text_list":         func() RichTextElement { return &RichTextList{} },
+	"rich_text_preformatted": func() RichTextElement { return &RichTextPreformatted{} },
+	"rich_text_quote":        func() RichTextElement { return &RichTextQuote{} },
+}
+
+// richTextInlineTypes maps rich text inline element types to a function which creates an element of that type.
+var richTextInlineTypes = map[string]func() RichTextInline{
+	"text":      func() RichTextInline { return &RichTextText{} },
+	"link":      func() RichTextInline { return &RichTextLink{} },
+	"emoji":     func() RichTextInline { return &RichTextEmoji{} },
+	"user":      func() RichTextInline { return &RichTextUser{} },
+	"channel":   func() RichTextInline { return &RichTextChannel{} },
+	"usergroup": func() RichTextInline { return &RichTextUserGroup{} },
+	"broadcast": func() RichTextInline { return &RichTextBroadcast{} },
+	"date":      func() RichTextInline { return &RichTextDate{} },
+	"color":     func() RichTextInline { return &RichTextColor{} },
+}
+
+// RichTextElement is a top level element of a RichTextBlock.
+type RichTextElement interface {
+	// RichTextType returns the type of the element e.g. rich_text_section.
+	RichTextType() string
+}
+
+// RichTextElements is a list of rich text elements which decodes each element from JSON according to its type.
+type RichTextElements []RichTextElement
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *RichTextElements) UnmarshalJSON(data []byte) error {
+	s, err := decodeTypedSlice(data, richTextTypes, "rich text element")
+	if err != nil {
+		return err
+	}
+	*e = s
+
+	return nil
+}
+
+// RichTextInline is an inline element of a rich text section, preformatted or quote element.
+type RichTextInline interface {
+	// InlineType returns the type of the element e.g. text.
+	InlineType() string
+}
+
+// RichTextInlines is a list of rich text inline elements which decodes each element from JSON according to its type.
+type RichTextInlines []RichTextInline
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *RichTextInlines) UnmarshalJSON(data []byte) error {
+	s, err := decodeTypedSlice(data, richTextInlineTypes, "rich text inline element")
+	if err != nil {
+		return err
+	}
+	*e = s
+
+	return nil
+}
+
+// RichTextSection is a section of rich text.
+type RichTextSection struct {
+	// Elements are the inline elements of the section.
+	Elements RichTextInlines `json:"elements"`
+
+	// Extra contains the fields of the decoded JSON which RichTextSection doesn't model.
+	// They're included when the element is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// RichTextType implements RichTextElement.
+func (RichTextSection) RichTextType() string {
+	return "rich_text_section"
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e RichTextSection) MarshalJSON() ([]byte, error) {
+	type alias RichTextSection
+	return marshalTyped(e.RichTextType(), alias(e), e.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *RichTextSection) UnmarshalJSON(data []byte) error {
+	type alias RichTextSection
+	extra, err := unmarshalTyped(data, (*alias)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+
+	return nil
+}
+
+const (
+	// ListStyleBullet is the style of a bulleted RichTextList.
+	ListStyleBullet = "bullet"
+
+	// ListStyleOrdered is the style of a numbered RichTextList.
+	ListStyleOrdered = "ordered"
+)
+
+// RichTextList is a bulleted or numbered list of rich text sections.
+type RichTextList struct {
+	// Style is the style of the list, ListStyleBullet or ListStyleOrdered.
+	Style string `json:"style"`
+
+	// Elements are the items of the list.
+	Elements []*RichTextSection `json:"elements"`
+
+	// Indent is the number of indents of the list.
+	Indent int `json:"indent,omitempty"`
+
+	// Offset is the number of items to offset the numbering of an ordered list by.
+	Offset int `json:"offset,omitempty"`
+
+	// Border is the width of the border of the list.
+	Border int `json:"border,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which RichTextList doesn't model.
+	// They're included when the element is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// RichTextType implements RichTextElement.
+func (RichTextList) RichTextType() string {
+	return "rich_text_list"
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e RichTextList) MarshalJSON() ([]byte, error) {
+	type alias RichTextList
+	return marshalTyped(e.RichTextType(), alias(e), e.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *RichTextList) UnmarshalJSON(data []byte) error {
+	type alias RichTextList
+	extra, err := unmarshalTyped(data, (*alias)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+
+	return nil
+}
+
+// RichTextPreformatted is a preformatted code block of rich text.
+type RichTextPreformatted struct {
+	// Elements are the inline elements of the block.
+	Elements RichTextInlines `json:"elements"`
+
+	// Border is the width of the border of the block.
+	Border int `json:"border,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which RichTextPreformatted doesn't model.
+	// They're included when the element is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// RichTextType implements RichTextElement.
+func (RichTextPreformatted) RichTextType() string {
+	return "rich_text_preformatted"
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e RichTextPreformatted) MarshalJSON() ([]byte, error) {
+	type alias RichTextPreformatted
+	return marshalTyped(e.RichTextType(), alias(e), e.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *RichTextPreformatted) UnmarshalJSON(data []byte) error {
+	type alias RichTextPreformatted
+	extra, err := unmarshalTyped(data, (*alias)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+
+	return nil
+}
+
+// RichTextQuote is a quote block of rich text.
+type RichTextQuote struct {
+	// Elements are the inline elements of the quote.
+	Elements RichTextInlines `json:"elements"`
+
+	// Border is the width of the border of the quote.
+	Border int `json:"border,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which RichTextQuote doesn't model.
+	// They're included when the element is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// RichTextType implements RichTextElement.
+func (RichTextQuote) RichTextType() string {
+	return "rich_text_quote"
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e RichTextQuote) MarshalJSON() ([]byte, error) {
+	type alias RichTextQuote
+	return marshalTyped(e.RichTextType(), alias(e), e.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *RichTextQuote) UnmarshalJSON(data []byte) error {
+	type alias RichTextQuote
+	extra, err := unmarshalTyped(data, (*alias)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+
+	return nil
+}
+
+// RichTextStyle is the style of rich text inline elements.
+type RichTextStyle struct {
+	Bold   bool `json:"bold,omitempty"`
+	Italic bool `json:"italic,omitempty"`
+	Strike bool `json:"strike,omitempty"`
+	Code   bool `json:"code,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which RichTextStyle doesn't model.
+	// They're included when the style is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (r RichTextStyle) MarshalJSON() ([]byte, error) {
+	type alias RichTextStyle
+	return marshalExtra(alias(r), r.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (r *RichTextStyle) UnmarshalJSON(data []byte) error {
+	type alias RichTextStyle
+	extra, err := unmarshalExtra(data, (*alias)(r))
+	if err != nil {
+		return err
+	}
+	r.Extra = extra
+
+	return nil
+}
+
+// RichTextText is plain text within rich text.
+type RichTextText struct {
+	// Text is the text.
+	Text string `json:"text"`
+
+	// Style is the optional style of the text.
+	Style *RichTextStyle `json:"style,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which RichTextText doesn't model.
+	// They're included when the element is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// InlineType implements RichTextInline.
+func (RichTextText) InlineType() string {
+	return "text"
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e RichTextText) MarshalJSON() ([]byte, error) {
+	type alias RichTextText
+	return marshalTyped(e.InlineType(), alias(e), e.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *RichTextText) UnmarshalJSON(data []byte) error {
+	type alias RichTextText
+	extra, err := unmarshalTyped(data, (*alias)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+
+	return nil
+}
+
+// RichTextLink is a hyperlink within rich text.
+type RichTextLink struct {
+	// URL is the URL of the link.
+	URL string `json:"url"`
+
+	// Text is the optional text shown instead of the URL.
+	Text string `json:"text,omitempty"`
+
+	// Unsafe indicates if the link is unsafe.
+	Unsafe bool `json:"unsafe,omitempty"`
+
+	// Style is the optional style of the link.
+	Style *RichTextStyle `json:"style,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which RichTextLink doesn't model.
+	// They're included when the element is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// InlineType implements RichTextInline.
+func (RichTextLink) InlineType() string {
+	return "link"
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e RichTextLink) MarshalJSON() ([]byte, error) {
+	type alias RichTextLink
+	return marshalTyped(e.InlineType(), alias(e), e.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *RichTextLink) UnmarshalJSON(data []byte) error {
+	type alias RichTextLink
+	extra, err := unmarshalTyped(data, (*alias)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+
+	return nil
+}
+
+// RichTextEmoji is an emoji within rich text.
+type RichTextEmoji struct {
+	// Name is the name of the emoji e.g. ghost.
+	Name string `json:"name"`
+
+	// Unicode is the optional unicode code point of the emoji.
+	Unicode string `json:"unicode,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which RichTextEmoji doesn't model.
+	// They're included when the element is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// InlineType implements RichTextInline.
+func (RichTextEmoji) InlineType() string {
+	return "emoji"
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e RichTextEmoji) MarshalJSON() ([]byte, error) {
+	type alias RichTextEmoji
+	return marshalTyped(e.InlineType(), alias(e), e.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *RichTextEmoji) UnmarshalJSON(data []byte) error {
+	type alias RichTextEmoji
+	extra, err := unmarshalTyped(data, (*alias)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+
+	return nil
+}
+
+// RichTextUser is a user mention within rich text.
+type RichTextUser struct {
+	// UserID is the ID of the user mentioned.
+	UserID string `json:"user_id"`
+
+	// Style is the optional style of the mention.
+	Style *RichTextStyle `json:"style,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which RichTextUser doesn't model.
+	// They're included when the element is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// InlineType implements RichTextInline.
+func (RichTextUser) InlineType() string {
+	return "user"
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e RichTextUser) MarshalJSON() ([]byte, error) {
+	type alias RichTextUser
+	return marshalTyped(e.InlineType(), alias(e), e.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *RichTextUser) UnmarshalJSON(data []byte) error {
+	type alias RichTextUser
+	extra, err := unmarshalTyped(data, (*alias)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+
+	return nil
+}
+
+// RichTextChannel is a channel mention within rich text.
+type RichTextChannel struct {
+	// ChannelID is the ID of the channel mentioned.
+	ChannelID string `json:"channel_id"`
+
+	// Style is the optional style of the mention.
+	Style *RichTextStyle `json:"style,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which RichTextChannel doesn't model.
+	// They're included when the element is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// InlineType implements RichTextInline.
+func (RichTextChannel) InlineType() string {
+	return "channel"
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e RichTextChannel) MarshalJSON() ([]byte, error) {
+	type alias RichTextChannel
+	return marshalTyped(e.InlineType(), alias(e), e.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *RichTextChannel) UnmarshalJSON(data []byte) error {
+	type alias RichTextChannel
+	extra, err := unmarshalTyped(data, (*alias)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+
+	return nil
+}
+
+// RichTextUserGroup is a user group mention within rich text.
+type RichTextUserGroup struct {
+	// UserGroupID is the ID of the user group mentioned.
+	UserGroupID string `json:"usergroup_id"`
+
+	// Style is the optional style of the mention.
+	Style *RichTextStyle `json:"style,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which RichTextUserGroup doesn't model.
+	// They're included when the element is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// InlineType implements RichTextInline.
+func (RichTextUserGroup) InlineType() string {
+	return "usergroup"
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e RichTextUserGroup) MarshalJSON() ([]byte, error) {
+	type alias RichTextUserGroup
+	return marshalTyped(e.InlineType(), alias(e), e.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *RichTextUserGroup) UnmarshalJSON(data []byte) error {
+	type alias RichTextUserGroup
+	extra, err := unmarshalTyped(data, (*alias)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+
+	return nil
+}
+
+// RichTextBroadcast is a special mention such as @here within rich text.
+type RichTextBroadcast struct {
+	// Range is the range of the mention, one of "here", "channel" or "everyone".
+	Range string `json:"range"`
+
+	// Extra contains the fields of the decoded JSON which RichTextBroadcast doesn't model.
+	// They're included when the element is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// InlineType implements RichTextInline.
+func (RichTextBroadcast) InlineType() string {
+	return "broadcast"
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e RichTextBroadcast) MarshalJSON() ([]byte, error) {
+	type alias RichTextBroadcast
+	return marshalTyped(e.InlineType(), alias(e), e.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *RichTextBroadcast) UnmarshalJSON(data []byte) error {
+	type alias RichTextBroadcast
+	extra, err := unmarshalTyped(data, (*alias)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+
+	return nil
+}
+
+// RichTextDate is a date displayed in the reader's local time zone within rich text.
+type RichTextDate struct {
+	// Timestamp is the unix timestamp of the date.
+	Timestamp int64 `json:"timestamp"`
+
+	// Format is the date format string e.g. {date_short} at {time}.
+	Format string `json:"format"`
+
+	// URL is an optional URL to link the date to.
+	URL string `json:"url,omitempty"`
+
+	// Fallback is the text shown if the date can't be formatted.
+	Fallback string `json:"fallback,omitempty"`
+
+	// Extra contains the fields of the decoded JSON which RichTextDate doesn't model.
+	// They're included when the element is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// InlineType implements RichTextInline.
+func (RichTextDate) InlineType() string {
+	return "date"
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e RichTextDate) MarshalJSON() ([]byte, error) {
+	type alias RichTextDate
+	return marshalTyped(e.InlineType(), alias(e), e.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *RichTextDate) UnmarshalJSON(data []byte) error {
+	type alias RichTextDate
+	extra, err := unmarshalTyped(data, (*alias)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+
+	return nil
+}
+
+// RichTextColor is a color swatch within rich text.
+type RichTextColor struct {
+	// Value is the hex color value e.g. #F405B3.
+	Value string `json:"value"`
+
+	// Extra contains the fields of the decoded JSON which RichTextColor doesn't model.
+	// They're included when the element is encoded so it survives a decode/encode cycle.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// InlineType implements RichTextInline.
+func (RichTextColor) InlineType() string {
+	return "color"
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e RichTextColor) MarshalJSON() ([]byte, error) {
+	type alias RichTextColor
+	return marshalTyped(e.InlineType(), alias(e), e.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *RichTextColor) UnmarshalJSON(data []byte) error {
+	type alias RichTextColor
+	extra, err := unmarshalTyped(data, (*alias)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+
+	return nil
+}
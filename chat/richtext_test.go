@@ -0,0 +1,74 @@
+package chat
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRichText(t *testing.T) {
+	bold := &RichTextStyle{Bold: true}
+	rt := &RichTextBlock{BlockID: "rt", Elements: RichTextElements{
+		&RichTextSection{Elements: RichTextInlines{
+			&RichTextText{Text: "Hi ", Style: bold},
+			&RichTextUser{UserID: "U123"},
+			&RichTextText{Text: " in "},
+			&RichTextChannel{ChannelID: "C123"},
+			&RichTextUserGroup{UserGroupID: "S123"},
+			&RichTextBroadcast{Range: "here"},
+			&RichTextEmoji{Name: "wave"},
+			&RichTextLink{URL: "https://example.com", Text: "example"},
+			&RichTextDate{Timestamp: 1392734382, Format: "{date_short}", Fallback: "Feb 18, 2014"},
+			&RichTextColor{Value: "#F405B3"},
+		}},
+		&RichTextList{Style: ListStyleBullet, Indent: 1, Elements: []*RichTextSection{
+			{Elements: RichTextInlines{&RichTextText{Text: "one"}}},
+			{Elements: RichTextInlines{&RichTextText{Text: "two"}}},
+		}},
+		&RichTextPreformatted{Elements: RichTextInlines{&RichTextText{Text: "code"}}},
+		&RichTextQuote{Elements: RichTextInlines{&RichTextText{Text: "quote"}}, Border: 1},
+	}}
+
+	b, err := json.Marshal(rt)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.JSONEq(t, `{
+		"type":"rich_text",
+		"block_id":"rt",
+		"elements":[
+			{"type":"rich_text_section","elements":[
+				{"type":"text","text":"Hi ","style":{"bold":true}},
+				{"type":"user","user_id":"U123"},
+				{"type":"text","text":" in "},
+				{"type":"channel","channel_id":"C123"},
+				{"type":"usergroup","usergroup_id":"S123"},
+				{"type":"broadcast","range":"here"},
+				{"type":"emoji","name":"wave"},
+				{"type":"link","url":"https://example.com","text":"example"},
+				{"type":"date","timestamp":1392734382,"format":"{date_short}","fallback":"Feb 18, 2014"},
+				{"type":"color","value":"#F405B3"}
+			]},
+			{"type":"rich_text_list","style":"bullet","indent":1,"elements":[
+				{"type":"rich_text_section","elements":[{"type":"text","text":"one"}]},
+				{"type":"rich_text_section","elements":[{"type":"text","text":"two"}]}
+			]},
+			{"type":"rich_text_preformatted","elements":[{"type":"text","text":"code"}]},
+			{"type":"rich_text_quote","elements":[{"type":"text","text":"quote"}],"border":1}
+		]
+	}`, string(b))
+
+	var blocks Blocks
+	if assert.NoError(t, json.Unmarshal([]byte("["+string(b)+"]"), &blocks)) {
+		assert.Equal(t, Blocks{rt}, blocks)
+	}
+}
+
+func TestRichTextUnmarshalError(t *testing.T) {
+	var e RichTextElements
+	assert.Error(t, json.Unmarshal([]byte(`[1]`), &e))
+
+	var i RichTextInlines
+	assert.Error(t, json.Unmarshal([]byte(`[1]`), &i))
+}
@@ -0,0 +1,304 @@
+package chat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/multiplay/go-slack"
+)
+
+const (
+	// ScheduleMessageEndpoint is the slack URL endpoint for chat schedule message.
+	ScheduleMessageEndpoint = "https://slack.com/api/chat.scheduleMessage"
+
+	// ScheduledMessagesListEndpoint is the slack URL endpoint for chat scheduled messages list.
+	ScheduledMessagesListEndpoint = "https://slack.com/api/chat.scheduledMessages.list"
+
+	// DeleteScheduledMessageEndpoint is the slack URL endpoint for chat delete scheduled message.
+	DeleteScheduledMessageEndpoint = "https://slack.com/api/chat.deleteScheduledMessage"
+)
+
+// Schedule is a request to post Message to its channel at PostAt.
+// Scheduling messages requires a token based client such as the api client.
+//
+// See: https://api.slack.com/methods/chat.scheduleMessage
+type Schedule struct {
+	Message
+
+	// PostAt is the time to post the message, which must be in the future
+	// and no more than 120 days from now. It's sent to the second.
+	PostAt time.Time
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s Schedule) MarshalJSON() ([]byte, error) {
+	return marshalWith(s.Message, map[string]interface{}{"post_at": unixTime(s.PostAt)})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *Schedule) UnmarshalJSON(data []byte) error {
+	var postAt unixTime
+	if err := unmarshalWith(data, &s.Message, map[string]interface{}{"post_at": &postAt}); err != nil {
+		return err
+	}
+	s.PostAt = time.Time(postAt)
+
+	return nil
+}
+
+// Send sends the schedule request to slack using the client c.
+func (s *Schedule) Send(c slack.Client) (*ScheduleResponse, error) {
+	return s.SendContext(context.Background(), c)
+}
+
+// SendContext sends the schedule request to slack using the client c and
+// ctx to control the lifetime of the request.
+func (s *Schedule) SendContext(ctx context.Context, c slack.Client) (*ScheduleResponse, error) {
+	resp := &ScheduleResponse{}
+	if err := slack.NewContextClient(c).SendContext(ctx, ScheduleMessageEndpoint, s, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// SendFit schedules the message using the client c, first fitting it within
+// the limits of slack using Fit with cfg.
+// Each message after the first is scheduled a second after the one before
+// so they're posted in order. cfg.Thread is ignored as scheduled messages
+// have no timestamp to reply to until they're posted.
+// It returns the responses for the messages which were scheduled successfully.
+func (s *Schedule) SendFit(c slack.Client, cfg FitConfig) ([]*ScheduleResponse, error) {
+	return s.SendFitContext(context.Background(), c, cfg)
+}
+
+// SendFitContext schedules the message using the client c and ctx to control
+// the lifetime of the requests, first fitting it within the limits of slack
+// using Fit with cfg.
+// Each message after the first is scheduled a second after the one before
+// so they're posted in order. cfg.Thread is ignored as scheduled messages
+// have no timestamp to reply to until they're posted.
+// It returns the responses for the messages which were scheduled successfully.
+func (s *Schedule) SendFitContext(ctx context.Context, c slack.Client, cfg FitConfig) ([]*ScheduleResponse, error) {
+	msgs := s.Message.Fit(cfg)
+	resps := make([]*ScheduleResponse, 0, len(msgs))
+	for i, m := range msgs {
+		fs := &Schedule{Message: *m, PostAt: s.PostAt.Add(time.Duration(i) * time.Second)}
+		resp, err := fs.SendContext(ctx, c)
+		if err != nil {
+			return resps, err
+		}
+		resps = append(resps, resp)
+	}
+
+	return resps, nil
+}
+
+// ScheduleResponse the response returned from the schedule message call.
+type ScheduleResponse struct {
+	slack.Response
+	Channel            string    `json:"channel,omitempty"`
+	ScheduledMessageID string    `json:"scheduled_message_id,omitempty"`
+	PostAt             time.Time `json:"-"`
+	Message            *Message  `json:"message,omitempty"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (r *ScheduleResponse) UnmarshalJSON(data []byte) error {
+	type alias ScheduleResponse
+	v := struct {
+		*alias
+		PostAt unixTime `json:"post_at"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	r.PostAt = time.Time(v.PostAt)
+
+	return nil
+}
+
+// Delete deletes the scheduled message r was returned for using the client c.
+func (r *ScheduleResponse) Delete(c slack.Client) (*slack.Response, error) {
+	return r.DeleteContext(context.Background(), c)
+}
+
+// DeleteContext deletes the scheduled message r was returned for using the
+// client c and ctx to control the lifetime of the request.
+func (r *ScheduleResponse) DeleteContext(ctx context.Context, c slack.Client) (*slack.Response, error) {
+	d := &DeleteScheduled{Channel: r.Channel, ScheduledMessageID: r.ScheduledMessageID}
+	return d.SendContext(ctx, c)
+}
+
+// ScheduledMessage is a message which is scheduled to be posted.
+type ScheduledMessage struct {
+	// ID is the scheduled_message_id of the message.
+	ID string `json:"id"`
+
+	// Channel is the ID of the channel the message will be posted to.
+	Channel string `json:"channel_id"`
+
+	// PostAt is the time the message will be posted.
+	PostAt time.Time `json:"-"`
+
+	// DateCreated is the time the message was scheduled.
+	DateCreated time.Time `json:"-"`
+
+	// Text is the text of the message.
+	Text string `json:"text,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (m ScheduledMessage) MarshalJSON() ([]byte, error) {
+	type alias ScheduledMessage
+	return json.Marshal(struct {
+		alias
+		PostAt      unixTime `json:"post_at"`
+		DateCreated unixTime `json:"date_created"`
+	}{alias: alias(m), PostAt: unixTime(m.PostAt), DateCreated: unixTime(m.DateCreated)})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (m *ScheduledMessage) UnmarshalJSON(data []byte) error {
+	type alias ScheduledMessage
+	v := struct {
+		*alias
+		PostAt      unixTime `json:"post_at"`
+		DateCreated unixTime `json:"date_created"`
+	}{alias: (*alias)(m)}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	m.PostAt = time.Time(v.PostAt)
+	m.DateCreated = time.Time(v.DateCreated)
+
+	return nil
+}
+
+// ScheduledList is a request to list the messages which are scheduled to be posted.
+// Results are paginated, use All to fetch every page.
+//
+// See: https://api.slack.com/methods/chat.scheduledMessages.list
+type ScheduledList struct {
+	// Channel if set limits the results to the channel with this ID.
+	Channel string `json:"channel,omitempty"`
+
+	// Cursor is the cursor returned by the previous page of results
+	// or empty to fetch the first page.
+	Cursor string `json:"cursor,omitempty"`
+
+	// Limit is the maximum number of results per page.
+	Limit int `json:"limit,omitempty"`
+
+	// TeamID is the team to list scheduled messages for, required if an
+	// org token is used.
+	TeamID string `json:"team_id,omitempty"`
+}
+
+// Send fetches a page of scheduled messages from slack using the client c.
+func (l *ScheduledList) Send(c slack.Client) (*ScheduledListResponse, error) {
+	return l.SendContext(context.Background(), c)
+}
+
+// SendContext fetches a page of scheduled messages from slack using the
+// client c and ctx to control the lifetime of the request.
+func (l *ScheduledList) SendContext(ctx context.Context, c slack.Client) (*ScheduledListResponse, error) {
+	resp := &ScheduledListResponse{}
+	if err := slack.NewContextClient(c).SendContext(ctx, ScheduledMessagesListEndpoint, l, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// All fetches every page of scheduled messages from slack using the client c,
+// starting at l.Cursor. l is not modified.
+func (l *ScheduledList) All(c slack.Client) ([]*ScheduledMessage, error) {
+	return l.AllContext(context.Background(), c)
+}
+
+// AllContext fetches every page of scheduled messages from slack using the
+// client c and ctx to control the lifetime of the requests, starting at
+// l.Cursor. l is not modified.
+// It returns the messages fetched before any error, which is
+// ErrCursorRepeated if slack returns the cursor of a page already fetched.
+func (l *ScheduledList) AllContext(ctx context.Context, c slack.Client) ([]*ScheduledMessage, error) {
+	pl := *l
+	var msgs []*ScheduledMessage
+	seen := cursors{}
+	for {
+		resp, err := pl.SendContext(ctx, c)
+		if err != nil {
+			return msgs, err
+		}
+		msgs = append(msgs, resp.ScheduledMessages...)
+
+		next, err := seen.next(pl.Cursor, resp.ResponseMetadata.NextCursor)
+		if next == "" {
+			return msgs, err
+		}
+		pl.Cursor = next
+	}
+}
+
+// ErrCursorRepeated is returned when fetching every page of results if slack
+// returns the cursor of a page which was already fetched, as continuing
+// would never finish.
+var ErrCursorRepeated = errors.New("chat: pagination cursor repeated")
+
+// cursors tracks the cursors of the pages fetched while paginating.
+type cursors map[string]bool
+
+// next records cur as fetched and returns the cursor of the next page, or ""
+// if there are no more pages or next was already fetched, in which case it
+// also returns ErrCursorRepeated.
+func (cs cursors) next(cur, next string) (string, error) {
+	cs[cur] = true
+	switch {
+	case next == "":
+		return "", nil
+	case cs[next]:
+		return "", ErrCursorRepeated
+	}
+
+	return next, nil
+}
+
+// ScheduledListResponse the response returned from the scheduled messages list call.
+type ScheduledListResponse struct {
+	slack.Response
+	ScheduledMessages []*ScheduledMessage    `json:"scheduled_messages"`
+	ResponseMetadata  slack.ResponseMetadata `json:"response_metadata"`
+}
+
+// DeleteScheduled is a request to delete a scheduled message before it's posted.
+//
+// See: https://api.slack.com/methods/chat.deleteScheduledMessage
+type DeleteScheduled struct {
+	// Channel is the ID of the channel the message is scheduled to be posted to.
+	Channel string `json:"channel"`
+
+	// ScheduledMessageID is the ID of the scheduled message to delete.
+	ScheduledMessageID string `json:"scheduled_message_id"`
+
+	// AsUser pass true to delete the message as the authed user.
+	AsUser bool `json:"as_user,omitempty"`
+}
+
+// Send sends the delete request to slack using the client c.
+func (d *DeleteScheduled) Send(c slack.Client) (*slack.Response, error) {
+	return d.SendContext(context.Background(), c)
+}
+
+// SendContext sends the delete request to slack using the client c and ctx
+// to control the lifetime of the request.
+func (d *DeleteScheduled) SendContext(ctx context.Context, c slack.Client) (*slack.Response, error) {
+	resp := &slack.Response{}
+	if err := slack.NewContextClient(c).SendContext(ctx, DeleteScheduledMessageEndpoint, d, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
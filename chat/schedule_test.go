@@ -0,0 +1,193 @@
+package chat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiplay/go-slack"
+	"github.com/multiplay/go-slack/api"
+	"github.com/multiplay/go-slack/slacktest"
+	"github.com/multiplay/go-slack/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduleJSON(t *testing.T) {
+	postAt := time.Unix(1562180400, 0)
+	s := &Schedule{Message: Message{Channel: "C123", Text: "maintenance at 10:00"}, PostAt: postAt}
+	b, err := json.Marshal(s)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.JSONEq(t, `{"channel":"C123","text":"maintenance at 10:00","post_at":1562180400}`, string(b))
+
+	s2 := &Schedule{}
+	if assert.NoError(t, json.Unmarshal(b, s2)) {
+		assert.True(t, postAt.Equal(s2.PostAt))
+		assert.Equal(t, s.Message, s2.Message)
+	}
+
+	// slack also encodes post_at as a string.
+	if assert.NoError(t, json.Unmarshal([]byte(`{"post_at":"1562180400"}`), s2)) {
+		assert.True(t, postAt.Equal(s2.PostAt))
+	}
+	assert.Error(t, json.Unmarshal([]byte(`{"post_at":"tomorrow"}`), s2))
+}
+
+func TestScheduleResponseJSON(t *testing.T) {
+	data := `{"ok":true,"channel":"C123","scheduled_message_id":"Q1298393284","post_at":"1562180400","message":{"text":"hi"}}`
+	r := &ScheduleResponse{}
+	if !assert.NoError(t, json.Unmarshal([]byte(data), r)) {
+		return
+	}
+	assert.True(t, r.OK)
+	assert.Equal(t, "C123", r.Channel)
+	assert.Equal(t, "Q1298393284", r.ScheduledMessageID)
+	assert.Equal(t, int64(1562180400), r.PostAt.Unix())
+	if assert.NotNil(t, r.Message) {
+		assert.Equal(t, "hi", r.Message.Text)
+	}
+}
+
+func TestScheduledMessageJSON(t *testing.T) {
+	data := `{"id":"Q1298393284","channel_id":"C123","post_at":1562180400,"date_created":1562177000,"text":"hi"}`
+	m := &ScheduledMessage{}
+	if !assert.NoError(t, json.Unmarshal([]byte(data), m)) {
+		return
+	}
+	assert.Equal(t, int64(1562180400), m.PostAt.Unix())
+	assert.Equal(t, int64(1562177000), m.DateCreated.Unix())
+
+	b, err := json.Marshal(m)
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, data, string(b))
+	}
+}
+
+func TestSchedule(t *testing.T) {
+	test.Server().Reset()
+	c := test.NewAPI()
+	postAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	var ids []string
+	for _, text := range []string{"a", "b", "c"} {
+		s := &Schedule{Message: Message{Channel: "C123", Text: text}, PostAt: postAt}
+		resp, err := s.Send(c)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, "C123", resp.Channel)
+		assert.NotEmpty(t, resp.ScheduledMessageID)
+		assert.True(t, postAt.Equal(resp.PostAt))
+		ids = append(ids, resp.ScheduledMessageID)
+	}
+
+	l := &ScheduledList{Channel: "C123", Limit: 2}
+	page, err := l.Send(c)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Len(t, page.ScheduledMessages, 2)
+	assert.NotEmpty(t, page.ResponseMetadata.NextCursor)
+
+	msgs, err := l.All(c)
+	if !assert.NoError(t, err) || !assert.Len(t, msgs, 3) {
+		return
+	}
+	assert.Empty(t, l.Cursor)
+	for i, m := range msgs {
+		assert.Equal(t, ids[i], m.ID)
+		assert.Equal(t, "C123", m.Channel)
+		assert.True(t, postAt.Equal(m.PostAt))
+	}
+	assert.Equal(t, "b", msgs[1].Text)
+
+	resp := &ScheduleResponse{Channel: "C123", ScheduledMessageID: ids[1]}
+	if _, err := resp.Delete(c); !assert.NoError(t, err) {
+		return
+	}
+	msgs, err = l.All(c)
+	if assert.NoError(t, err) && assert.Len(t, msgs, 2) {
+		assert.Equal(t, ids[0], msgs[0].ID)
+		assert.Equal(t, ids[2], msgs[1].ID)
+	}
+
+	_, err = resp.Delete(c)
+	assert.True(t, errors.Is(err, slack.ErrInvalidScheduledMessageID))
+}
+
+func TestScheduleErrors(t *testing.T) {
+	c := test.NewAPI()
+	s := &Schedule{Message: Message{Channel: "C123", Text: "a"}, PostAt: time.Now().Add(-time.Hour)}
+	_, err := s.Send(c)
+	assert.True(t, errors.Is(err, slack.ErrTimeInPast))
+
+	s.PostAt = time.Now().Add(200 * 24 * time.Hour)
+	_, err = s.Send(c)
+	assert.True(t, errors.Is(err, slack.ErrTimeTooFar))
+
+	s.PostAt = time.Time{}
+	_, err = s.Send(c)
+	assert.True(t, errors.Is(err, slack.ErrInvalidTime))
+
+	msgs, err := (&ScheduledList{Cursor: "bogus"}).All(c)
+	assert.Empty(t, msgs)
+	assert.True(t, errors.Is(err, slack.ErrInvalidCursor))
+}
+
+// newRepeatingServer returns a slacktest.Server whose method always returns
+// a page of results with the same next_cursor, and a client which uses it.
+func newRepeatingServer(method, results string) (*slacktest.Server, slack.Client) {
+	s := slacktest.NewServer()
+	s.Handle(method, func(s *slacktest.Server, r slacktest.Request) interface{} {
+		return map[string]interface{}{
+			"ok":                true,
+			"has_more":          true,
+			results:             []map[string]interface{}{{"text": "page"}},
+			"response_metadata": map[string]interface{}{"next_cursor": "same"},
+		}
+	})
+
+	c := api.New("xoxb-test")
+	return s, slack.ClientFunc(func(ctx context.Context, u string, msg, resp interface{}) error {
+		return c.SendContext(ctx, s.APIURL(path.Base(u)), msg, resp)
+	})
+}
+
+func TestScheduledListRepeatedCursor(t *testing.T) {
+	s, c := newRepeatingServer("chat.scheduledMessages.list", "scheduled_messages")
+	defer s.Close()
+
+	msgs, err := (&ScheduledList{}).All(c)
+	assert.True(t, errors.Is(err, ErrCursorRepeated))
+	assert.Len(t, msgs, 2)
+	assert.Len(t, s.Requests(), 2)
+}
+
+func TestScheduleSendFit(t *testing.T) {
+	test.Server().Reset()
+	postAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	s := &Schedule{Message: Message{Channel: "C123", Text: strings.Repeat("a", MaxTextLen+1)}, PostAt: postAt}
+	resps, err := s.SendFit(test.NewAPI(), FitConfig{Split: true, Thread: true})
+	if !assert.NoError(t, err) || !assert.Len(t, resps, 2) {
+		return
+	}
+	assert.True(t, postAt.Equal(resps[0].PostAt))
+	assert.True(t, postAt.Add(time.Second).Equal(resps[1].PostAt))
+
+	// Nothing is posted until the scheduled time.
+	for _, r := range test.Server().Requests() {
+		assert.Equal(t, "chat.scheduleMessage", r.Method)
+		assert.NotContains(t, r.Values(), "thread_ts")
+	}
+	assert.Len(t, test.Server().Scheduled(), 2)
+
+	s.PostAt = time.Now().Add(-time.Hour)
+	resps, err = s.SendFit(test.NewAPI(), FitConfig{Split: true})
+	assert.Empty(t, resps)
+	assert.True(t, errors.Is(err, slack.ErrTimeInPast))
+}
@@ -0,0 +1,209 @@
+package chat
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/multiplay/go-slack"
+)
+
+const (
+	// RepliesEndpoint is the slack URL endpoint for conversations replies.
+	RepliesEndpoint = "https://slack.com/api/conversations.replies"
+)
+
+// Thread is the thread of replies to a message which was posted to slack.
+// Replying to and fetching the replies of a thread requires a token based
+// client such as the api client, as other clients don't return the
+// timestamp of the parent message.
+type Thread struct {
+	// Client is the client used to send replies and fetch them.
+	Client slack.Client
+
+	// Channel is the ID of the channel containing the thread.
+	Channel string
+
+	// Timestamp is the timestamp (ts) of the thread's parent message.
+	Timestamp string
+}
+
+// Thread returns the thread of the message r was returned for,
+// which uses the client c.
+func (r *MessageResponse) Thread(c slack.Client) *Thread {
+	return &Thread{Client: c, Channel: r.Channel, Timestamp: r.Timestamp}
+}
+
+// Reply posts m as a reply in the thread.
+// m.Channel and m.ThreadTS are ignored and m is not modified.
+func (t *Thread) Reply(m *Message) (*MessageResponse, error) {
+	return t.ReplyContext(context.Background(), m)
+}
+
+// ReplyContext posts m as a reply in the thread using ctx to control the
+// lifetime of the request.
+// m.Channel and m.ThreadTS are ignored and m is not modified.
+func (t *Thread) ReplyContext(ctx context.Context, m *Message) (*MessageResponse, error) {
+	return t.reply(ctx, m, m.ReplyBroadcast)
+}
+
+// Broadcast posts m as a reply in the thread which is also sent to the channel.
+// m.Channel and m.ThreadTS are ignored and m is not modified.
+func (t *Thread) Broadcast(m *Message) (*MessageResponse, error) {
+	return t.BroadcastContext(context.Background(), m)
+}
+
+// BroadcastContext posts m as a reply in the thread which is also sent to
+// the channel using ctx to control the lifetime of the request.
+// m.Channel and m.ThreadTS are ignored and m is not modified.
+func (t *Thread) BroadcastContext(ctx context.Context, m *Message) (*MessageResponse, error) {
+	return t.reply(ctx, m, true)
+}
+
+func (t *Thread) reply(ctx context.Context, m *Message, broadcast bool) (*MessageResponse, error) {
+	pm := *m
+	pm.Channel = t.Channel
+	pm.ThreadTS = t.Timestamp
+	pm.ReplyBroadcast = broadcast
+
+	return pm.SendContext(ctx, t.Client)
+}
+
+// Replies fetches every message in the thread, starting with the parent,
+// including their Metadata.
+func (t *Thread) Replies() ([]*ThreadMessage, error) {
+	return t.RepliesContext(context.Background())
+}
+
+// RepliesContext fetches every message in the thread, starting with the
+// parent, including their Metadata, using ctx to control the lifetime of
+// the requests.
+func (t *Thread) RepliesContext(ctx context.Context) ([]*ThreadMessage, error) {
+	r := &Replies{Channel: t.Channel, Timestamp: t.Timestamp, IncludeAllMetadata: true}
+	return r.AllContext(ctx, t.Client)
+}
+
+// ThreadMessage is a message in a thread as returned by Replies.
+type ThreadMessage struct {
+	Message
+
+	// Timestamp is the timestamp (ts) of the message.
+	Timestamp string
+
+	// User is the ID of the user who posted the message, if posted by a user.
+	User string
+}
+
+// MarshalJSON implements json.Marshaler.
+func (m ThreadMessage) MarshalJSON() ([]byte, error) {
+	with := make(map[string]interface{})
+	if m.Timestamp != "" {
+		with["ts"] = m.Timestamp
+	}
+	if m.User != "" {
+		with["user"] = m.User
+	}
+
+	return marshalWith(m.Message, with)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (m *ThreadMessage) UnmarshalJSON(data []byte) error {
+	return unmarshalWith(data, &m.Message, map[string]interface{}{"ts": &m.Timestamp, "user": &m.User})
+}
+
+// Replies is a request to fetch the messages of a thread.
+// Results are paginated, use All to fetch every page.
+//
+// See: https://api.slack.com/methods/conversations.replies
+type Replies struct {
+	// Channel is the ID of the channel containing the thread.
+	Channel string
+
+	// Timestamp is the timestamp (ts) of the thread's parent message.
+	Timestamp string
+
+	// Cursor is the cursor returned by the previous page of results
+	// or empty to fetch the first page.
+	Cursor string
+
+	// Limit is the maximum number of results per page.
+	Limit int
+
+	// IncludeAllMetadata if true includes the Metadata of the messages.
+	IncludeAllMetadata bool
+}
+
+// values returns the form arguments of the request, as conversations.replies
+// doesn't accept JSON.
+func (r *Replies) values() url.Values {
+	v := url.Values{"channel": {r.Channel}, "ts": {r.Timestamp}}
+	if r.Cursor != "" {
+		v.Set("cursor", r.Cursor)
+	}
+	if r.Limit != 0 {
+		v.Set("limit", strconv.Itoa(r.Limit))
+	}
+	if r.IncludeAllMetadata {
+		v.Set("include_all_metadata", "true")
+	}
+
+	return v
+}
+
+// Send fetches a page of messages from slack using the client c.
+func (r *Replies) Send(c slack.Client) (*RepliesResponse, error) {
+	return r.SendContext(context.Background(), c)
+}
+
+// SendContext fetches a page of messages from slack using the client c and
+// ctx to control the lifetime of the request.
+func (r *Replies) SendContext(ctx context.Context, c slack.Client) (*RepliesResponse, error) {
+	resp := &RepliesResponse{}
+	if err := slack.NewContextClient(c).SendContext(ctx, RepliesEndpoint, r.values(), resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// All fetches every page of messages from slack using the client c,
+// starting at r.Cursor. r is not modified.
+func (r *Replies) All(c slack.Client) ([]*ThreadMessage, error) {
+	return r.AllContext(context.Background(), c)
+}
+
+// AllContext fetches every page of messages from slack using the client c
+// and ctx to control the lifetime of the requests, starting at r.Cursor.
+// r is not modified.
+// It returns the messages fetched before any error, which is
+// ErrCursorRepeated if slack returns the cursor of a page already fetched.
+func (r *Replies) AllContext(ctx context.Context, c slack.Client) ([]*ThreadMessage, error) {
+	pr := *r
+	var msgs []*ThreadMessage
+	seen := cursors{}
+	for {
+		resp, err := pr.SendContext(ctx, c)
+		if err != nil {
+			return msgs, err
+		}
+		msgs = append(msgs, resp.Messages...)
+
+		if !resp.HasMore {
+			return msgs, nil
+		}
+		next, err := seen.next(pr.Cursor, resp.ResponseMetadata.NextCursor)
+		if next == "" {
+			return msgs, err
+		}
+		pr.Cursor = next
+	}
+}
+
+// RepliesResponse the response returned from the conversations replies call.
+type RepliesResponse struct {
+	slack.Response
+	Messages         []*ThreadMessage       `json:"messages"`
+	HasMore          bool                   `json:"has_more,omitempty"`
+	ResponseMetadata slack.ResponseMetadata `json:"response_metadata"`
+}
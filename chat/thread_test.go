@@ -0,0 +1,112 @@
+package chat
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/multiplay/go-slack"
+	"github.com/multiplay/go-slack/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestThread(t *testing.T) {
+	c := test.NewAPI()
+	resp, err := (&Message{Channel: "C123", Text: "deploy started"}).Send(c)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	th := resp.Thread(c)
+	assert.Equal(t, "C123", th.Channel)
+	assert.Equal(t, resp.Timestamp, th.Timestamp)
+
+	m := &Message{Channel: "#ignored", Text: "step 1"}
+	r1, err := th.Reply(m)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "#ignored", m.Channel)
+	assert.Empty(t, m.ThreadTS)
+
+	r, ok := test.Server().LastRequest()
+	if assert.True(t, ok) {
+		v := r.Values()
+		assert.Equal(t, "C123", v["channel"])
+		assert.Equal(t, resp.Timestamp, v["thread_ts"])
+		assert.NotContains(t, v, "reply_broadcast")
+	}
+
+	r2, err := th.Broadcast(&Message{Text: "deploy finished"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	r, ok = test.Server().LastRequest()
+	if assert.True(t, ok) {
+		assert.Equal(t, true, r.Values()["reply_broadcast"])
+	}
+
+	msgs, err := th.Replies()
+	if !assert.NoError(t, err) || !assert.Len(t, msgs, 3) {
+		return
+	}
+	assert.Equal(t, resp.Timestamp, msgs[0].Timestamp)
+	assert.Equal(t, "deploy started", msgs[0].Text)
+	assert.Equal(t, r1.Timestamp, msgs[1].Timestamp)
+	assert.Equal(t, resp.Timestamp, msgs[1].ThreadTS)
+	assert.Equal(t, r2.Timestamp, msgs[2].Timestamp)
+	assert.True(t, msgs[2].ReplyBroadcast)
+
+	r, ok = test.Server().LastRequest()
+	if assert.True(t, ok) {
+		assert.Equal(t, "conversations.replies", r.Method)
+		assert.Equal(t, "application/x-www-form-urlencoded", r.Header.Get("Content-Type"))
+	}
+
+	// Fetch one message per page.
+	l := &Replies{Channel: "C123", Timestamp: resp.Timestamp, Limit: 1}
+	page, err := l.Send(c)
+	if assert.NoError(t, err) {
+		assert.Len(t, page.Messages, 1)
+		assert.True(t, page.HasMore)
+	}
+	all, err := l.All(c)
+	if assert.NoError(t, err) {
+		assert.Equal(t, msgs, all)
+	}
+}
+
+func TestThreadRepliesRepeatedCursor(t *testing.T) {
+	s, c := newRepeatingServer("conversations.replies", "messages")
+	defer s.Close()
+
+	msgs, err := (&Replies{Channel: "C123", Timestamp: "1.000000"}).All(c)
+	assert.True(t, errors.Is(err, ErrCursorRepeated))
+	assert.Len(t, msgs, 2)
+	assert.Len(t, s.Requests(), 2)
+}
+
+func TestThreadRepliesError(t *testing.T) {
+	th := &Thread{Client: test.NewAPI(), Channel: "C123", Timestamp: "1.000000"}
+	msgs, err := th.Replies()
+	assert.Empty(t, msgs)
+	assert.True(t, errors.Is(err, slack.ErrThreadNotFound))
+}
+
+func TestThreadMessageJSON(t *testing.T) {
+	data := `{"type":"message","user":"U123","text":"hi","thread_ts":"1.000000","ts":"2.000000"}`
+	m := &ThreadMessage{}
+	if !assert.NoError(t, json.Unmarshal([]byte(data), m)) {
+		return
+	}
+	assert.Equal(t, "2.000000", m.Timestamp)
+	assert.Equal(t, "U123", m.User)
+	assert.Equal(t, "1.000000", m.ThreadTS)
+	assert.Equal(t, map[string]json.RawMessage{"type": json.RawMessage(`"message"`)}, m.Extra)
+
+	b, err := json.Marshal(m)
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, data, string(b))
+	}
+}
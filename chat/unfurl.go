@@ -0,0 +1,71 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/multiplay/go-slack"
+)
+
+const (
+	// UnfurlEndpoint is the slack URL endpoint for chat unfurl.
+	UnfurlEndpoint = "https://slack.com/api/chat.unfurl"
+)
+
+// Unfurl is a request to provide custom unfurls for the URLs in a message,
+// in response to a link_shared event.
+// The message is identified by either Channel and Timestamp or by UnfurlID
+// and Source.
+// Unfurling requires a token based client such as the api client.
+//
+// See: https://api.slack.com/methods/chat.unfurl
+type Unfurl struct {
+	// Channel is the ID of the channel containing the message.
+	Channel string `json:"channel,omitempty"`
+
+	// Timestamp is the timestamp (ts) of the message.
+	Timestamp string `json:"ts,omitempty"`
+
+	// Unfurls maps the URLs in the message to their unfurl, which can
+	// use the fields of an attachment or Block Kit blocks.
+	Unfurls map[string]*Attachment `json:"unfurls"`
+
+	// UnfurlID is the unfurl_id of the link_shared event.
+	UnfurlID string `json:"unfurl_id,omitempty"`
+
+	// Source is the source of the link_shared event, "composer" or
+	// "conversations_history".
+	Source string `json:"source,omitempty"`
+
+	// UserAuthRequired if true asks the user to authenticate to see the unfurls.
+	UserAuthRequired bool `json:"user_auth_required,omitempty"`
+
+	// UserAuthMessage is the message shown to the user asking them to authenticate.
+	UserAuthMessage string `json:"user_auth_message,omitempty"`
+
+	// UserAuthURL is the URL the user is sent to to authenticate.
+	UserAuthURL string `json:"user_auth_url,omitempty"`
+}
+
+// Add adds a as the unfurl of url.
+func (u *Unfurl) Add(url string, a *Attachment) {
+	if u.Unfurls == nil {
+		u.Unfurls = make(map[string]*Attachment)
+	}
+	u.Unfurls[url] = a
+}
+
+// Send sends the unfurl request to slack using the client c.
+func (u *Unfurl) Send(c slack.Client) (*slack.Response, error) {
+	return u.SendContext(context.Background(), c)
+}
+
+// SendContext sends the unfurl request to slack using the client c and ctx
+// to control the lifetime of the request.
+func (u *Unfurl) SendContext(ctx context.Context, c slack.Client) (*slack.Response, error) {
+	resp := &slack.Response{}
+	if err := slack.NewContextClient(c).SendContext(ctx, UnfurlEndpoint, u, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
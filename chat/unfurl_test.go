@@ -0,0 +1,59 @@
+package chat
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/multiplay/go-slack"
+	"github.com/multiplay/go-slack/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnfurlJSON(t *testing.T) {
+	u := &Unfurl{Channel: "C123", Timestamp: "1.000000"}
+	u.Add("https://wiki.internal/page", &Attachment{Title: "Page", Text: "summary"})
+	b, err := json.Marshal(u)
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, `{"channel":"C123","ts":"1.000000","unfurls":{"https://wiki.internal/page":{"title":"Page","text":"summary"}}}`, string(b))
+	}
+}
+
+func TestUnfurlSend(t *testing.T) {
+	c := test.NewAPI()
+	resp, err := (&Message{Channel: "C123", Text: "see https://wiki.internal/page"}).Send(c)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	u := &Unfurl{Channel: resp.Channel, Timestamp: resp.Timestamp}
+	u.Add("https://wiki.internal/page", &Attachment{Title: "Page", Text: "summary"})
+	if _, err := u.Send(c); !assert.NoError(t, err) {
+		return
+	}
+
+	msg, ok := test.Server().Message(resp.Channel, resp.Timestamp)
+	if assert.True(t, ok) {
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"from_url": "https://wiki.internal/page", "title": "Page", "text": "summary"},
+		}, msg["attachments"])
+	}
+
+	// Unfurls from the composer aren't for a posted message.
+	u = &Unfurl{UnfurlID: "Uxxxxxxx-909b5454-75f8-4ac4-b325-1b40e230bbd8", Source: "composer"}
+	u.Add("https://wiki.internal/page", &Attachment{Text: "summary"})
+	_, err = u.Send(c)
+	assert.NoError(t, err)
+}
+
+func TestUnfurlSendError(t *testing.T) {
+	c := test.NewAPI()
+	_, err := (&Unfurl{Channel: "C123", Timestamp: "1.000000"}).Send(c)
+	assert.True(t, errors.Is(err, slack.ErrInvalidUnfurlsFormat))
+
+	u := &Unfurl{Channel: "C123", Timestamp: "1.000000"}
+	u.Add("https://wiki.internal/page", &Attachment{Text: "summary"})
+	_, err = u.Send(c)
+	assert.True(t, errors.Is(err, slack.ErrMessageNotFound))
+}
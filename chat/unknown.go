@@ -0,0 +1,49 @@
+package chat
+
+import (
+	"encoding/json"
+)
+
+// Unknown is a block, element or rich text element of a type which isn't
+// modelled by this package. It retains the raw JSON it was decoded from so
+// that it survives a decode/encode cycle unchanged.
+type Unknown struct {
+	// Type is the Block Kit type of the object.
+	Type string
+
+	// Raw is the JSON encoding of the object.
+	Raw json.RawMessage
+}
+
+// BlockType implements Block.
+func (u Unknown) BlockType() string {
+	return u.Type
+}
+
+// ElementType implements Element.
+func (u Unknown) ElementType() string {
+	return u.Type
+}
+
+// RichTextType implements RichTextElement.
+func (u Unknown) RichTextType() string {
+	return u.Type
+}
+
+// InlineType implements RichTextInline.
+func (u Unknown) InlineType() string {
+	return u.Type
+}
+
+// contextElement marks Unknown as a ContextElement.
+func (Unknown) contextElement() {}
+
+// MarshalJSON implements json.Marshaler.
+// It returns Raw or, if that's empty, an object containing only Type.
+func (u Unknown) MarshalJSON() ([]byte, error) {
+	if len(u.Raw) != 0 {
+		return u.Raw, nil
+	}
+
+	return marshalTyped(u.Type, struct{}{}, nil)
+}
@@ -0,0 +1,52 @@
+package chat
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnknown(t *testing.T) {
+	u := &Unknown{Type: "video", Raw: []byte(`{"type":"video","title":"t"}`)}
+	assert.Equal(t, "video", u.BlockType())
+	assert.Equal(t, "video", u.ElementType())
+	assert.Equal(t, "video", u.RichTextType())
+	assert.Equal(t, "video", u.InlineType())
+
+	b, err := json.Marshal(u)
+	if assert.NoError(t, err) {
+		assert.Equal(t, `{"type":"video","title":"t"}`, string(b))
+	}
+
+	b, err = json.Marshal(&Unknown{Type: "video"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, `{"type":"video"}`, string(b))
+	}
+}
+
+func TestUnknownRichText(t *testing.T) {
+	data := `[{"type":"rich_text_new","x":1},{"type":"rich_text_section","elements":[{"type":"new_inline","y":2}]}]`
+	var e RichTextElements
+	if !assert.NoError(t, json.Unmarshal([]byte(data), &e)) {
+		return
+	}
+
+	b, err := json.Marshal(e)
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, data, string(b))
+	}
+}
+
+func TestUnknownContextElement(t *testing.T) {
+	data := `[{"type":"new_context","x":1}]`
+	var e ContextElements
+	if !assert.NoError(t, json.Unmarshal([]byte(data), &e)) {
+		return
+	}
+
+	b, err := json.Marshal(e)
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, data, string(b))
+	}
+}
@@ -0,0 +1,92 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/multiplay/go-slack"
+)
+
+const (
+	// UpdateEndpoint is the slack URL endpoint for chat update.
+	UpdateEndpoint = "https://slack.com/api/chat.update"
+)
+
+// Update is a request to update an existing message, replacing its text,
+// blocks and attachments with those of Message.
+// Channel must be the ID of the channel the message was posted to, which
+// is returned in MessageResponse.Channel.
+//
+// Updating messages requires a token based client such as the api client.
+//
+// See: https://api.slack.com/methods/chat.update
+type Update struct {
+	Message
+
+	// Timestamp is the timestamp (ts) of the message to update.
+	Timestamp string
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u Update) MarshalJSON() ([]byte, error) {
+	return marshalWith(u.Message, map[string]interface{}{"ts": u.Timestamp})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (u *Update) UnmarshalJSON(data []byte) error {
+	return unmarshalWith(data, &u.Message, map[string]interface{}{"ts": &u.Timestamp})
+}
+
+// Send sends the update to slack using the client c.
+func (u *Update) Send(c slack.Client) (*MessageResponse, error) {
+	return u.SendContext(context.Background(), c)
+}
+
+// SendContext sends the update to slack using the client c and ctx to control
+// the lifetime of the request.
+// The response identifies the updated message so it can be updated again.
+func (u *Update) SendContext(ctx context.Context, c slack.Client) (*MessageResponse, error) {
+	resp := &MessageResponse{}
+	if err := slack.NewContextClient(c).SendContext(ctx, UpdateEndpoint, u, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// SendFit sends the update to slack using the client c, first fitting it
+// within the limits of slack using Fit with cfg.
+// An update replaces a single message so cfg.Split and cfg.Thread are
+// ignored and content which exceeds the limits is always truncated.
+func (u *Update) SendFit(c slack.Client, cfg FitConfig) (*MessageResponse, error) {
+	return u.SendFitContext(context.Background(), c, cfg)
+}
+
+// SendFitContext sends the update to slack using the client c and ctx to
+// control the lifetime of the request, first fitting it within the limits
+// of slack using Fit with cfg.
+// An update replaces a single message so cfg.Split and cfg.Thread are
+// ignored and content which exceeds the limits is always truncated.
+func (u *Update) SendFitContext(ctx context.Context, c slack.Client, cfg FitConfig) (*MessageResponse, error) {
+	cfg.Split, cfg.Thread = false, false
+	fu := &Update{Message: *u.Message.Fit(cfg)[0], Timestamp: u.Timestamp}
+
+	return fu.SendContext(ctx, c)
+}
+
+// Update replaces the message r was returned for with m using the client c.
+// m.Channel is ignored, the message is updated in the channel it was posted to.
+// The returned response can be used to update the message again.
+func (r *MessageResponse) Update(c slack.Client, m *Message) (*MessageResponse, error) {
+	return r.UpdateContext(context.Background(), c, m)
+}
+
+// UpdateContext replaces the message r was returned for with m using the
+// client c and ctx to control the lifetime of the request.
+// m.Channel is ignored, the message is updated in the channel it was posted to.
+// The returned response can be used to update the message again.
+func (r *MessageResponse) UpdateContext(ctx context.Context, c slack.Client, m *Message) (*MessageResponse, error) {
+	u := &Update{Message: *m, Timestamp: r.Timestamp}
+	u.Channel = r.Channel
+
+	return u.SendContext(ctx, c)
+}
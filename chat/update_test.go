@@ -0,0 +1,117 @@
+package chat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/multiplay/go-slack"
+	"github.com/multiplay/go-slack/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateJSON(t *testing.T) {
+	u := &Update{Message: Message{Channel: "C123", Text: "updated"}, Timestamp: "1503435956.000247"}
+	b, err := json.Marshal(u)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.JSONEq(t, `{"channel":"C123","text":"updated","ts":"1503435956.000247"}`, string(b))
+
+	// Timestamp takes precedence over a ts decoded into Extra.
+	u.Extra = map[string]json.RawMessage{"ts": json.RawMessage(`"1.000000"`), "foo": json.RawMessage(`1`)}
+	b, err = json.Marshal(u)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.JSONEq(t, `{"channel":"C123","text":"updated","ts":"1503435956.000247","foo":1}`, string(b))
+
+	u2 := &Update{}
+	if assert.NoError(t, json.Unmarshal(b, u2)) {
+		assert.Equal(t, "1503435956.000247", u2.Timestamp)
+		assert.Equal(t, "updated", u2.Text)
+		assert.Equal(t, map[string]json.RawMessage{"foo": json.RawMessage(`1`)}, u2.Extra)
+	}
+
+	assert.Error(t, json.Unmarshal([]byte(`{"ts":1}`), u2))
+}
+
+func TestMessageResponseUpdate(t *testing.T) {
+	c := test.NewAPI()
+	m := &Message{Channel: "C123", Text: "deploying"}
+	resp, err := m.Send(c)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	for _, text := range []string{"deploying: 50%", "deployed"} {
+		resp, err = resp.Update(c, &Message{Channel: "#ignored", Text: text})
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, "C123", resp.Channel)
+		if assert.NotNil(t, resp.Message) {
+			assert.Equal(t, text, resp.Message.Text)
+		}
+	}
+
+	r, ok := test.Server().LastRequest()
+	if assert.True(t, ok) {
+		assert.Equal(t, "chat.update", r.Method)
+		u := &Update{}
+		if assert.NoError(t, r.Decode(u)) {
+			assert.Equal(t, "C123", u.Channel)
+			assert.Equal(t, resp.Timestamp, u.Timestamp)
+		}
+	}
+
+	stored, ok := test.Server().Message(resp.Channel, resp.Timestamp)
+	if assert.True(t, ok) {
+		assert.Equal(t, "deployed", stored["text"])
+	}
+}
+
+func TestUpdateSendError(t *testing.T) {
+	u := &Update{Message: Message{Channel: "C123", Text: "updated"}, Timestamp: "1.000000"}
+	_, err := u.Send(test.NewAPI())
+	assert.True(t, errors.Is(err, slack.ErrMessageNotFound))
+}
+
+func TestUpdateContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	r := &MessageResponse{Channel: "C123", Timestamp: "1.000000"}
+	_, err := r.UpdateContext(ctx, test.NewAPI(), &Message{Text: "updated"})
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestUpdateSendFit(t *testing.T) {
+	test.Server().Reset()
+	c := test.NewAPI()
+	resp, err := (&Message{Channel: "C123", Text: "deploying"}).Send(c)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	u := &Update{Message: Message{Channel: resp.Channel, Text: strings.Repeat("a", MaxTextLen+1)}, Timestamp: resp.Timestamp}
+	resp, err = u.SendFit(c, FitConfig{Split: true, Thread: true})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, u.Timestamp, resp.Timestamp)
+
+	// Only the existing message is updated, nothing new is posted.
+	reqs := test.Server().Requests()
+	if assert.Len(t, reqs, 2) {
+		assert.Equal(t, "chat.update", reqs[1].Method)
+		sent := &Update{}
+		if assert.NoError(t, reqs[1].Decode(sent)) {
+			assert.Equal(t, u.Timestamp, sent.Timestamp)
+			assert.Equal(t, Truncate(u.Text, MaxTextLen, DefaultEllipsis), sent.Text)
+		}
+	}
+}
@@ -0,0 +1,439 @@
+package chat
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode/utf8"
+)
+
+// Limits documented by slack for messages, attachments and blocks.
+// Lengths are measured in characters (runes) not bytes.
+const (
+	// MaxTextLen is the maximum length of a message's text.
+	MaxTextLen = 40000
+
+	// MaxBlocks is the maximum number of blocks in a message or attachment.
+	MaxBlocks = 50
+
+	// MaxAttachments is the maximum number of attachments in a message.
+	MaxAttachments = 100
+
+	// MaxAttachmentFields is the maximum number of fields in an attachment.
+	MaxAttachmentFields = 100
+
+	// MaxFooterLen is the maximum length of an attachment's footer.
+	MaxFooterLen = 300
+
+	// MaxBlockIDLen is the maximum length of a block_id.
+	MaxBlockIDLen = 255
+
+	// MaxActionIDLen is the maximum length of an action_id.
+	MaxActionIDLen = 255
+
+	// MaxSectionTextLen is the maximum length of a section block's text.
+	MaxSectionTextLen = 3000
+
+	// MaxSectionFields is the maximum number of fields in a section block.
+	MaxSectionFields = 10
+
+	// MaxSectionFieldLen is the maximum length of the text of a section block field.
+	MaxSectionFieldLen = 2000
+
+	// MaxHeaderTextLen is the maximum length of a header block's text.
+	MaxHeaderTextLen = 150
+
+	// MaxContextElements is the maximum number of elements in a context block.
+	MaxContextElements = 10
+
+	// MaxActionsElements is the maximum number of elements in an actions block.
+	MaxActionsElements = 25
+
+	// MaxImageURLLen is the maximum length of an image URL.
+	MaxImageURLLen = 3000
+
+	// MaxAltTextLen is the maximum length of an image's alt text.
+	MaxAltTextLen = 2000
+
+	// MaxLabelLen is the maximum length of an input block's label and hint.
+	MaxLabelLen = 2000
+
+	// MaxButtonTextLen is the maximum length of a button's text.
+	MaxButtonTextLen = 75
+
+	// MaxButtonValueLen is the maximum length of a button's value.
+	MaxButtonValueLen = 2000
+
+	// MaxButtonURLLen is the maximum length of a button's URL.
+	MaxButtonURLLen = 3000
+)
+
+// ValidationError is a single violation found by Validate.
+type ValidationError struct {
+	// Path is the JSON path like location of the violation e.g. blocks[2].text.
+	Path string
+
+	// Message describes the violation.
+	Message string
+}
+
+// Error implements error.
+func (e *ValidationError) Error() string {
+	if e.Path == "" {
+		return e.Message
+	}
+
+	return e.Path + ": " + e.Message
+}
+
+// ValidationErrors is the error returned by Validate which lists every violation found.
+type ValidationErrors []*ValidationError
+
+// Error implements error.
+func (e ValidationErrors) Error() string {
+	s := make([]string, len(e))
+	for i, err := range e {
+		s[i] = err.Error()
+	}
+
+	return fmt.Sprintf("chat: %d validation error(s): %s", len(e), strings.Join(s, "; "))
+}
+
+// Unwrap returns the individual violations so they can be inspected with errors.As.
+func (e ValidationErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+
+	return errs
+}
+
+// Validate checks m against the limits documented by slack, returning
+// ValidationErrors listing every violation or nil if m is valid.
+func (m *Message) Validate() error {
+	v := &validator{}
+	m.validate(v)
+
+	return v.err()
+}
+
+// Validate checks a against the limits documented by slack, returning
+// ValidationErrors listing every violation or nil if a is valid.
+func (a *Attachment) Validate() error {
+	v := &validator{}
+	a.validate(v, "")
+
+	return v.err()
+}
+
+// Validate checks f is valid, returning ValidationErrors listing every
+// violation or nil if f is valid.
+func (f *Field) Validate() error {
+	v := &validator{}
+	f.validate(v, "")
+
+	return v.err()
+}
+
+func (m *Message) validate(v *validator) {
+	if m.Text == "" && len(m.Blocks) == 0 && len(m.Attachments) == 0 {
+		v.errorf("", "one of text, blocks or attachments is required")
+	}
+	v.maxLen("text", m.Text, MaxTextLen)
+	v.blocks("blocks", m.Blocks)
+
+	if len(m.Attachments) > MaxAttachments {
+		v.errorf("attachments", "has %d attachments, maximum is %d", len(m.Attachments), MaxAttachments)
+	}
+	for i, a := range m.Attachments {
+		p := index("attachments", i)
+		if a == nil {
+			v.errorf(p, "is null")
+			continue
+		}
+		a.validate(v, p)
+	}
+
+	if m.Metadata != nil {
+		v.required("metadata.event_type", m.Metadata.EventType)
+		if !isObject(m.Metadata.EventPayload) {
+			v.errorf("metadata.event_payload", "must be a JSON object")
+		}
+	}
+}
+
+func (a *Attachment) validate(v *validator, path string) {
+	v.maxLen(join(path, "footer"), a.Footer, MaxFooterLen)
+	v.blocks(join(path, "blocks"), a.Blocks)
+
+	if len(a.Fields) > MaxAttachmentFields {
+		v.errorf(join(path, "fields"), "has %d fields, maximum is %d", len(a.Fields), MaxAttachmentFields)
+	}
+	for i, f := range a.Fields {
+		p := index(join(path, "fields"), i)
+		if f == nil {
+			v.errorf(p, "is null")
+			continue
+		}
+		f.validate(v, p)
+	}
+}
+
+func (f *Field) validate(v *validator, path string) {
+	if f.Title == "" && f.Value == "" {
+		v.errorf(path, "one of title or value is required")
+	}
+}
+
+// validator collects the violations found during validation.
+type validator struct {
+	errs ValidationErrors
+}
+
+// err returns the violations found as an error or nil if there were none.
+func (v *validator) err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+
+	return v.errs
+}
+
+func (v *validator) errorf(path, format string, args ...interface{}) {
+	v.errs = append(v.errs, &ValidationError{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) required(path, s string) {
+	if s == "" {
+		v.errorf(path, "is required")
+	}
+}
+
+func (v *validator) maxLen(path, s string, max int) {
+	if n := utf8.RuneCountInString(s); n > max {
+		v.errorf(path, "length %d exceeds maximum of %d", n, max)
+	}
+}
+
+// text validates the text object t. If plain is true t must be plain text.
+func (v *validator) text(path string, t *TextObject, max int, plain bool) {
+	if t == nil {
+		v.errorf(path, "is required")
+		return
+	}
+
+	switch {
+	case t.Type == PlainTextType:
+	case t.Type == MarkdownType && !plain:
+	case plain:
+		v.errorf(join(path, "type"), "must be %q", PlainTextType)
+	default:
+		v.errorf(join(path, "type"), "must be %q or %q", PlainTextType, MarkdownType)
+	}
+
+	v.required(join(path, "text"), t.Text)
+	v.maxLen(join(path, "text"), t.Text, max)
+}
+
+func (v *validator) blocks(path string, blocks Blocks) {
+	if len(blocks) > MaxBlocks {
+		v.errorf(path, "has %d blocks, maximum is %d", len(blocks), MaxBlocks)
+	}
+
+	ids := make(map[string]string)
+	for i, b := range blocks {
+		p := index(path, i)
+		if pointer(b) == nil {
+			v.errorf(p, "is null")
+			continue
+		}
+
+		if id := blockID(b); id != "" {
+			v.maxLen(join(p, "block_id"), id, MaxBlockIDLen)
+			if prev, ok := ids[id]; ok {
+				v.errorf(join(p, "block_id"), "%q duplicates %s", id, prev)
+			} else {
+				ids[id] = join(p, "block_id")
+			}
+		}
+
+		v.block(p, b)
+	}
+}
+
+func (v *validator) block(path string, b Block) {
+	switch b := pointer(b).(type) {
+	case *SectionBlock:
+		if b.Text == nil && len(b.Fields) == 0 {
+			v.errorf(path, "one of text or fields is required")
+		}
+		if b.Text != nil {
+			v.text(join(path, "text"), b.Text, MaxSectionTextLen, false)
+		}
+		if len(b.Fields) > MaxSectionFields {
+			v.errorf(join(path, "fields"), "has %d fields, maximum is %d", len(b.Fields), MaxSectionFields)
+		}
+		for i, f := range b.Fields {
+			v.text(index(join(path, "fields"), i), f, MaxSectionFieldLen, false)
+		}
+		if b.Accessory != nil {
+			v.element(join(path, "accessory"), b.Accessory, map[string]string{})
+		}
+	case *HeaderBlock:
+		v.text(join(path, "text"), b.Text, MaxHeaderTextLen, true)
+	case *ContextBlock:
+		v.count(join(path, "elements"), len(b.Elements), MaxContextElements)
+		for i, e := range b.Elements {
+			p := index(join(path, "elements"), i)
+			switch e := pointer(e).(type) {
+			case *TextObject:
+				v.text(p, e, MaxTextLen, false)
+			case *ImageElement:
+				v.image(p, e.ImageURL, e.AltText)
+			case nil:
+				v.errorf(p, "is null")
+			}
+		}
+	case *ImageBlock:
+		v.image(path, b.ImageURL, b.AltText)
+		if b.Title != nil {
+			v.text(join(path, "title"), b.Title, MaxAltTextLen, true)
+		}
+	case *ActionsBlock:
+		v.count(join(path, "elements"), len(b.Elements), MaxActionsElements)
+		ids := make(map[string]string)
+		for i, e := range b.Elements {
+			v.element(index(join(path, "elements"), i), e, ids)
+		}
+	case *InputBlock:
+		v.text(join(path, "label"), b.Label, MaxLabelLen, true)
+		if b.Hint != nil {
+			v.text(join(path, "hint"), b.Hint, MaxLabelLen, true)
+		}
+		if b.Element == nil {
+			v.errorf(join(path, "element"), "is required")
+		} else {
+			v.element(join(path, "element"), b.Element, map[string]string{})
+		}
+	case *FileBlock:
+		v.required(join(path, "external_id"), b.ExternalID)
+		if b.Source != "remote" {
+			v.errorf(join(path, "source"), `must be "remote"`)
+		}
+	case *RichTextBlock:
+		if len(b.Elements) == 0 {
+			v.errorf(join(path, "elements"), "is required")
+		}
+	}
+}
+
+// count checks that a list which must not be empty has at most max entries.
+func (v *validator) count(path string, n, max int) {
+	switch {
+	case n == 0:
+		v.errorf(path, "is required")
+	case n > max:
+		v.errorf(path, "has %d elements, maximum is %d", n, max)
+	}
+}
+
+func (v *validator) image(path, url, alt string) {
+	v.required(join(path, "image_url"), url)
+	v.maxLen(join(path, "image_url"), url, MaxImageURLLen)
+	v.required(join(path, "alt_text"), alt)
+	v.maxLen(join(path, "alt_text"), alt, MaxAltTextLen)
+}
+
+// element validates the element e of a block. ids tracks the action_ids
+// of the block so they can be checked for uniqueness as slack requires.
+func (v *validator) element(path string, e Element, ids map[string]string) {
+	if pointer(e) == nil {
+		v.errorf(path, "is null")
+		return
+	}
+
+	if id := actionID(e); id != "" {
+		v.maxLen(join(path, "action_id"), id, MaxActionIDLen)
+		if prev, ok := ids[id]; ok {
+			v.errorf(join(path, "action_id"), "%q duplicates %s", id, prev)
+		} else {
+			ids[id] = join(path, "action_id")
+		}
+	}
+
+	switch e := pointer(e).(type) {
+	case *ButtonElement:
+		v.text(join(path, "text"), e.Text, MaxButtonTextLen, true)
+		v.maxLen(join(path, "value"), e.Value, MaxButtonValueLen)
+		v.maxLen(join(path, "url"), e.URL, MaxButtonURLLen)
+	case *ImageElement:
+		v.image(path, e.ImageURL, e.AltText)
+	}
+}
+
+// pointer returns a pointer to a copy of v if v is a struct value so
+// blocks and elements created as values are validated the same as pointers,
+// or nil if v is a nil pointer so it's treated the same as a nil interface.
+func pointer[T any](v T) T {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Ptr:
+		if rv.IsNil() {
+			var zero T
+			return zero
+		}
+		return v
+	case reflect.Struct:
+	default:
+		return v
+	}
+
+	p := reflect.New(rv.Type())
+	p.Elem().Set(rv)
+	if pv, ok := p.Interface().(T); ok {
+		return pv
+	}
+
+	return v
+}
+
+// blockID returns the block_id of b or "" if it doesn't have one.
+func blockID(b Block) string {
+	return stringField(b, "BlockID")
+}
+
+// actionID returns the action_id of e or "" if it doesn't have one.
+func actionID(e Element) string {
+	return stringField(e, "ActionID")
+}
+
+// stringField returns the value of the string field name of the struct
+// or pointer to struct v, or "" if it doesn't have one.
+func stringField(v interface{}, name string) string {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return ""
+	}
+
+	f := rv.FieldByName(name)
+	if f.Kind() != reflect.String {
+		return ""
+	}
+
+	return f.String()
+}
+
+// join returns the path of the field name within path.
+func join(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
+
+// index returns the path of the i'th entry of the list path.
+func index(path string, i int) string {
+	return fmt.Sprintf("%s[%d]", path, i)
+}
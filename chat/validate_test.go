@@ -0,0 +1,166 @@
+package chat
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// paths returns the paths of the violations in err.
+func paths(t *testing.T, err error) []string {
+	var errs ValidationErrors
+	if !assert.True(t, errors.As(err, &errs)) {
+		return nil
+	}
+
+	p := make([]string, len(errs))
+	for i, e := range errs {
+		p[i] = e.Path
+	}
+
+	return p
+}
+
+func TestMessageValidate(t *testing.T) {
+	m := &Message{Text: "hello"}
+	m.AddBlock(&SectionBlock{BlockID: "b1", Text: NewMarkdownText("*hi*")})
+	m.AddBlock(DividerBlock{})
+	m.AddBlock(&ActionsBlock{Elements: Elements{NewButton("a1", "Ok", "ok"), NewButton("a2", "Cancel", "cancel")}})
+	m.NewAttachment().NewField("key", "val")
+	assert.NoError(t, m.Validate())
+
+	assert.Equal(t, []string{""}, paths(t, (&Message{}).Validate()))
+}
+
+func TestMessageValidateLimits(t *testing.T) {
+	m := &Message{Text: strings.Repeat("é", MaxTextLen+1)}
+	for i := 0; i <= MaxBlocks; i++ {
+		m.AddBlock(&DividerBlock{})
+	}
+	for i := 0; i <= MaxAttachments; i++ {
+		m.NewAttachment()
+	}
+	m.Attachments[1].Footer = strings.Repeat("f", MaxFooterLen+1)
+
+	err := m.Validate()
+	if !assert.Error(t, err) {
+		return
+	}
+	assert.Equal(t, []string{"text", "blocks", "attachments", "attachments[1].footer"}, paths(t, err))
+	assert.Contains(t, err.Error(), "text: length 40001 exceeds maximum of 40000")
+	assert.Contains(t, err.Error(), "chat: 4 validation error(s)")
+
+	var ve *ValidationError
+	if assert.True(t, errors.As(err, &ve)) {
+		assert.Equal(t, "text", ve.Path)
+	}
+}
+
+func TestMessageValidateBlocks(t *testing.T) {
+	m := &Message{}
+	m.AddBlock(&SectionBlock{BlockID: "dup"})
+	m.AddBlock(&SectionBlock{
+		BlockID:   "dup",
+		Text:      NewMarkdownText(strings.Repeat("x", MaxSectionTextLen+1)),
+		Fields:    make([]*TextObject, MaxSectionFields+1),
+		Accessory: &ButtonElement{ActionID: "a"},
+	})
+	m.AddBlock(&HeaderBlock{Text: NewMarkdownText("header")})
+	m.AddBlock(&ContextBlock{})
+	m.AddBlock(ImageBlock{})
+	m.AddBlock(&ActionsBlock{Elements: Elements{NewButton("a", "One", "1"), nil, NewButton("a", "Two", "2")}})
+	m.AddBlock(&InputBlock{Label: NewPlainText("label")})
+	m.AddBlock(&FileBlock{})
+	m.AddBlock(&RichTextBlock{})
+	m.AddBlock(&Unknown{Type: "video"})
+	m.AddBlock(nil)
+	m.AddBlock((*SectionBlock)(nil))
+	m.AddBlock(&ContextBlock{Elements: ContextElements{(*TextObject)(nil)}})
+	m.AddBlock(&ActionsBlock{Elements: Elements{(*ButtonElement)(nil)}})
+	m.AddBlock(&SectionBlock{Text: NewPlainText("text"), Accessory: (*ImageElement)(nil)})
+
+	assert.Equal(t, []string{
+		"blocks[0]",
+		"blocks[1].block_id",
+		"blocks[1].text.text",
+		"blocks[1].fields",
+		"blocks[1].fields[0]",
+		"blocks[1].fields[1]",
+		"blocks[1].fields[2]",
+		"blocks[1].fields[3]",
+		"blocks[1].fields[4]",
+		"blocks[1].fields[5]",
+		"blocks[1].fields[6]",
+		"blocks[1].fields[7]",
+		"blocks[1].fields[8]",
+		"blocks[1].fields[9]",
+		"blocks[1].fields[10]",
+		"blocks[1].accessory.text",
+		"blocks[2].text.type",
+		"blocks[3].elements",
+		"blocks[4].image_url",
+		"blocks[4].alt_text",
+		"blocks[5].elements[1]",
+		"blocks[5].elements[2].action_id",
+		"blocks[6].element",
+		"blocks[7].external_id",
+		"blocks[7].source",
+		"blocks[8].elements",
+		"blocks[10]",
+		"blocks[11]",
+		"blocks[12].elements[0]",
+		"blocks[13].elements[0]",
+		"blocks[14].accessory",
+	}, paths(t, m.Validate()))
+}
+
+func TestMessageValidateMetadata(t *testing.T) {
+	m := &Message{Text: "deployed"}
+	if !assert.NoError(t, m.SetMetadata("deploy_finished", map[string]int{"build_id": 42})) {
+		return
+	}
+	assert.NoError(t, m.Validate())
+
+	m.Metadata = &Metadata{EventPayload: json.RawMessage(`[1]`)}
+	assert.Equal(t, []string{"metadata.event_type", "metadata.event_payload"}, paths(t, m.Validate()))
+}
+
+func TestAttachmentValidate(t *testing.T) {
+	a := &Attachment{Footer: "footer"}
+	a.NewField("key", "val")
+	assert.NoError(t, a.Validate())
+
+	a.Footer = strings.Repeat("f", MaxFooterLen+1)
+	a.AddField(&Field{})
+	a.AddField(nil)
+	a.AddBlock(&HeaderBlock{})
+	assert.Equal(t, []string{"footer", "blocks[0].text", "fields[1]", "fields[2]"}, paths(t, a.Validate()))
+}
+
+func TestAttachmentValidateFields(t *testing.T) {
+	a := &Attachment{}
+	for i := 0; i < MaxAttachmentFields; i++ {
+		a.NewField("key", "val")
+	}
+	assert.NoError(t, a.Validate())
+
+	a.NewField("key", "val")
+	err := a.Validate()
+	if assert.Error(t, err) {
+		assert.Equal(t, []string{"fields"}, paths(t, err))
+		assert.Contains(t, err.Error(), "fields: has 101 fields, maximum is 100")
+	}
+}
+
+func TestFieldValidate(t *testing.T) {
+	assert.NoError(t, NewField("key", "").Validate())
+	assert.NoError(t, NewField("", "val").Validate())
+
+	err := (&Field{}).Validate()
+	if assert.Error(t, err) {
+		assert.Equal(t, "chat: 1 validation error(s): one of title or value is required", err.Error())
+	}
+}
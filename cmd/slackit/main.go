@@ -5,34 +5,53 @@
 //
 // By default slackit reads the message from stdin.
 //
+// The message is validated against slack's documented limits before it's posted.
+//
+// With -md the source is read as Markdown which is converted to slack's
+// mrkdwn format, or with -blocks to Block Kit blocks, and posted.
+//
+// With -preview the message is displayed in the terminal, instead of being
+// posted, using colours unless the NO_COLOR environment variable is set.
+//
 // Example:
 //  slackit -hook https://hooks.slack.com/services/T00/B00/XXX -src msg.json
+//  slackit -hook https://hooks.slack.com/services/T00/B00/XXX -md -src CHANGELOG.md
 package main
 
 import (
 	"encoding/json"
 	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
 	"log"
 	"os"
 
 	"github.com/multiplay/go-slack/chat"
+	"github.com/multiplay/go-slack/render"
 	"github.com/multiplay/go-slack/webhook"
 )
 
 // exit is the function used to exit on error its a variable so it can be easily overridden for tests.
 var exit = os.Exit
 
+// stdout is where previews are written its a variable so it can be easily overridden for tests.
+var stdout io.Writer = os.Stdout
+
 func main() {
 	var src = flag.String("src", "-", "reads the message from the specified file or stdin if '-'")
 	var hook = flag.String("hook", "", "the hook url to use")
+	var md = flag.Bool("md", false, "reads the message as Markdown and converts it to mrkdwn")
+	var blocks = flag.Bool("blocks", false, "with -md converts the Markdown to Block Kit blocks")
+	var preview = flag.Bool("preview", false, "displays the message instead of posting it")
 
 	flag.Usage = func() {
-		log.Printf("usage %s -hook <url> [-src <file>]\nflags:\n", os.Args[0])
+		log.Printf("usage %s -hook <url> [-src <file>] [-md [-blocks]] [-preview]\nflags:\n", os.Args[0])
 		flag.PrintDefaults()
 	}
 
 	flag.Parse()
-	if *hook == "" {
+	if *hook == "" && !*preview {
 		flag.Usage()
 		exit(1)
 	}
@@ -49,13 +68,40 @@ func main() {
 		}
 	}
 
-	dec := json.NewDecoder(f)
 	m := &chat.Message{}
-	if err := dec.Decode(m); err != nil {
-		log.Println("failed to decode message: ", err)
+	if *md {
+		b, err := ioutil.ReadAll(f)
+		if err != nil {
+			log.Println("failed to read markdown: ", err)
+			exit(1)
+		}
+
+		m.Text = chat.MarkdownToMrkdwn(string(b))
+		if *blocks {
+			m.Blocks = chat.MarkdownToBlocks(string(b))
+		}
+	} else {
+		dec := json.NewDecoder(f)
+		if err := dec.Decode(m); err != nil {
+			log.Println("failed to decode message: ", err)
+			exit(1)
+		}
+	}
+
+	if err := m.Validate(); err != nil {
+		log.Println("invalid message:", err)
 		exit(1)
 	}
 
+	if *preview {
+		if os.Getenv("NO_COLOR") != "" {
+			fmt.Fprint(stdout, render.Text(m))
+		} else {
+			fmt.Fprint(stdout, render.ANSI(m))
+		}
+		return
+	}
+
 	c := webhook.New(*hook)
 	if _, err := m.Send(c); err != nil {
 		log.Println("failed to send message:", err)
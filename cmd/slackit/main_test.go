@@ -13,8 +13,8 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-func testSlackit(t *testing.T, hook, msg string) ([]byte, error) {
-	args := make([]string, 0, 2)
+func testSlackit(t *testing.T, hook, msg string, flags ...string) ([]byte, error) {
+	args := append([]string{}, flags...)
 	if hook != "" {
 		args = append(args, "-hook", hook)
 	}
@@ -43,6 +43,7 @@ func testSlackit(t *testing.T, hook, msg string) ([]byte, error) {
 	var buf bytes.Buffer
 	var err error
 
+	stdout = &buf
 	exit = func(code int) {
 		if code != 0 {
 			err = fmt.Errorf("exit(%v)", code)
@@ -70,19 +71,91 @@ func TestMissingHook(t *testing.T) {
 }
 
 func TestInvalidMessage(t *testing.T) {
-	b, err := testSlackit(t, test.Endpoint, "broken")
+	b, err := testSlackit(t, test.URL(), "broken")
 	assert.Error(t, err)
 	assert.Contains(t, string(b), "failed to decode message")
 }
 
 func TestInvalidFile(t *testing.T) {
-	b, err := testSlackit(t, test.Endpoint, "invalid-file")
+	b, err := testSlackit(t, test.URL(), "invalid-file")
 	assert.Error(t, err)
 	assert.Contains(t, string(b), "failed to decode message")
 }
 
+func TestValidationFailure(t *testing.T) {
+	b, err := testSlackit(t, test.URL(), `{"blocks":[{"type":"header","text":{"type":"mrkdwn","text":"title"}}]}`)
+	assert.Error(t, err)
+	assert.Contains(t, string(b), "invalid message")
+	assert.Contains(t, string(b), "blocks[0].text.type")
+}
+
 func TestSuccess(t *testing.T) {
-	b, err := testSlackit(t, test.Endpoint, `{"text":"my message"}`)
+	b, err := testSlackit(t, test.URL(), `{"text":"my message"}`)
+	assert.NoError(t, err)
+	assert.Empty(t, b)
+}
+
+func TestUnknownFields(t *testing.T) {
+	msg := `{"text":"my message","blocks":[{"type":"video","title":"demo"}],"new_field":{"a":1}}`
+	b, err := testSlackit(t, test.URL(), msg)
+	assert.NoError(t, err)
+	assert.Empty(t, b)
+
+	r, ok := test.Server().LastRequest()
+	if assert.True(t, ok) {
+		assert.JSONEq(t, msg, string(r.Body))
+	}
+}
+
+func TestMarkdown(t *testing.T) {
+	b, err := testSlackit(t, test.URL(), "# Release\n\nSome **bold** text", "-md")
+	assert.NoError(t, err)
+	assert.Empty(t, b)
+
+	r, ok := test.Server().LastRequest()
+	if assert.True(t, ok) {
+		assert.JSONEq(t, `{"text":"*Release*\n\nSome *bold* text"}`, string(r.Body))
+	}
+}
+
+func TestMarkdownBlocks(t *testing.T) {
+	b, err := testSlackit(t, test.URL(), "# Release\n\nSome **bold** text", "-md", "-blocks")
 	assert.NoError(t, err)
 	assert.Empty(t, b)
+
+	r, ok := test.Server().LastRequest()
+	if assert.True(t, ok) {
+		assert.JSONEq(t, `{
+			"text":"*Release*\n\nSome *bold* text",
+			"blocks":[
+				{"type":"header","text":{"type":"plain_text","text":"Release"}},
+				{"type":"section","text":{"type":"mrkdwn","text":"Some *bold* text"}}
+			]
+		}`, string(r.Body))
+	}
+}
+
+func TestMarkdownInvalidFile(t *testing.T) {
+	b, err := testSlackit(t, test.URL(), "invalid-file", "-md")
+	assert.Error(t, err)
+	assert.Contains(t, string(b), "failed to read markdown")
+}
+
+func TestPreview(t *testing.T) {
+	test.Server().Reset()
+	os.Setenv("NO_COLOR", "1")
+	defer os.Unsetenv("NO_COLOR")
+
+	b, err := testSlackit(t, "", `{"text":"my *message*","attachments":[{"title":"Build"}]}`, "-preview")
+	assert.NoError(t, err)
+	assert.Equal(t, "my message\n\n| Build\n", string(b))
+
+	_, ok := test.Server().LastRequest()
+	assert.False(t, ok)
+}
+
+func TestPreviewANSI(t *testing.T) {
+	b, err := testSlackit(t, "", `{"text":"my *message*"}`, "-preview")
+	assert.NoError(t, err)
+	assert.Equal(t, "my \x1b[1mmessage\x1b[22m\n", string(b))
 }
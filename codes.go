@@ -0,0 +1,131 @@
+package slack
+
+// ErrorCode is an error code returned by the Slack API in the error field
+// of a response or in the body of a failed webhook request.
+//
+// ErrorCode implements error so the constants can be used with errors.Is e.g.
+//
+//	if errors.Is(err, slack.ErrChannelNotFound) {
+//		...
+//	}
+type ErrorCode string
+
+// Error codes common to all Web API methods.
+// See: https://api.slack.com/web#errors
+const (
+	ErrNotAuthed           ErrorCode = "not_authed"
+	ErrInvalidAuth         ErrorCode = "invalid_auth"
+	ErrAccountInactive     ErrorCode = "account_inactive"
+	ErrTokenRevoked        ErrorCode = "token_revoked"
+	ErrTokenExpired        ErrorCode = "token_expired"
+	ErrNoPermission        ErrorCode = "no_permission"
+	ErrMissingScope        ErrorCode = "missing_scope"
+	ErrNotAllowedTokenType ErrorCode = "not_allowed_token_type"
+	ErrOrgLoginRequired    ErrorCode = "org_login_required"
+	ErrEKMAccessDenied     ErrorCode = "ekm_access_denied"
+	ErrInvalidArguments    ErrorCode = "invalid_arguments"
+	ErrInvalidArgName      ErrorCode = "invalid_arg_name"
+	ErrInvalidCharset      ErrorCode = "invalid_charset"
+	ErrInvalidFormData     ErrorCode = "invalid_form_data"
+	ErrInvalidPostType     ErrorCode = "invalid_post_type"
+	ErrMissingPostType     ErrorCode = "missing_post_type"
+	ErrTeamAddedToOrg      ErrorCode = "team_added_to_org"
+	ErrRequestTimeout      ErrorCode = "request_timeout"
+	ErrRateLimited         ErrorCode = "rate_limited"
+	ErrRatelimited         ErrorCode = "ratelimited"
+	ErrFatalError          ErrorCode = "fatal_error"
+	ErrInternalError       ErrorCode = "internal_error"
+	ErrServiceUnavailable  ErrorCode = "service_unavailable"
+)
+
+// Error codes returned by the chat methods.
+// See: https://api.slack.com/methods/chat.postMessage#errors
+const (
+	ErrChannelNotFound     ErrorCode = "channel_not_found"
+	ErrNotInChannel        ErrorCode = "not_in_channel"
+	ErrIsArchived          ErrorCode = "is_archived"
+	ErrMsgTooLong          ErrorCode = "msg_too_long"
+	ErrNoText              ErrorCode = "no_text"
+	ErrTooManyAttachments  ErrorCode = "too_many_attachments"
+	ErrInvalidBlocks       ErrorCode = "invalid_blocks"
+	ErrInvalidBlocksFormat ErrorCode = "invalid_blocks_format"
+	ErrRestrictedAction    ErrorCode = "restricted_action"
+	ErrMessageNotFound     ErrorCode = "message_not_found"
+	ErrCantUpdateMessage   ErrorCode = "cant_update_message"
+	ErrCantDeleteMessage   ErrorCode = "cant_delete_message"
+	ErrEditWindowClosed    ErrorCode = "edit_window_closed"
+	ErrUserNotFound        ErrorCode = "user_not_found"
+	ErrUserNotInChannel    ErrorCode = "user_not_in_channel"
+)
+
+// Error codes returned by the chat.unfurl method.
+// See: https://api.slack.com/methods/chat.unfurl#errors
+const (
+	ErrCannotUnfurlURL      ErrorCode = "cannot_unfurl_url"
+	ErrInvalidUnfurlsFormat ErrorCode = "invalid_unfurls_format"
+)
+
+// Error codes returned by the scheduled message methods.
+// See: https://api.slack.com/methods/chat.scheduleMessage#errors
+const (
+	ErrInvalidTime               ErrorCode = "invalid_time"
+	ErrTimeInPast                ErrorCode = "time_in_past"
+	ErrTimeTooFar                ErrorCode = "time_too_far"
+	ErrInvalidScheduledMessageID ErrorCode = "invalid_scheduled_message_id"
+	ErrInvalidCursor             ErrorCode = "invalid_cursor"
+)
+
+// Error codes returned by the conversations methods.
+// See: https://api.slack.com/methods/conversations.replies#errors
+const (
+	ErrThreadNotFound ErrorCode = "thread_not_found"
+)
+
+// Error codes returned by incoming webhooks.
+// See: https://api.slack.com/messaging/webhooks#handling_errors
+const (
+	ErrInvalidPayload                ErrorCode = "invalid_payload"
+	ErrActionProhibited              ErrorCode = "action_prohibited"
+	ErrPostingToGeneralChannelDenied ErrorCode = "posting_to_general_channel_denied"
+	ErrChannelIsArchived             ErrorCode = "channel_is_archived"
+	ErrNoService                     ErrorCode = "no_service"
+	ErrNoServiceID                   ErrorCode = "no_service_id"
+	ErrNoTeam                        ErrorCode = "no_team"
+	ErrTeamDisabled                  ErrorCode = "team_disabled"
+	ErrInvalidToken                  ErrorCode = "invalid_token"
+)
+
+// Error implements error.
+func (c ErrorCode) Error() string {
+	return string(c)
+}
+
+// Retryable returns true if the request which failed with c may succeed if retried.
+func (c ErrorCode) Retryable() bool {
+	switch c {
+	case ErrRateLimited, ErrRatelimited, ErrRequestTimeout, ErrInternalError, ErrServiceUnavailable:
+		return true
+	}
+
+	return false
+}
+
+// Temporary is an alias for Retryable.
+func (c ErrorCode) Temporary() bool {
+	return c.Retryable()
+}
+
+// isErrorCode returns true if s looks like a slack error code e.g. channel_not_found.
+func isErrorCode(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '_' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,22 @@
+package slack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorCode(t *testing.T) {
+	assert.Equal(t, "channel_not_found", ErrChannelNotFound.Error())
+	assert.True(t, ErrRateLimited.Retryable())
+	assert.True(t, ErrServiceUnavailable.Temporary())
+	assert.False(t, ErrInvalidAuth.Retryable())
+}
+
+func TestIsErrorCode(t *testing.T) {
+	assert.True(t, isErrorCode("channel_not_found"))
+	assert.True(t, isErrorCode("invalid_arg_name2"))
+	assert.False(t, isErrorCode(""))
+	assert.False(t, isErrorCode("my error"))
+	assert.False(t, isErrorCode("Invalid"))
+}
@@ -0,0 +1,32 @@
+package slack
+
+import (
+	"context"
+)
+
+// contextClient adapts a Client which isn't context aware into a ContextClient.
+type contextClient struct {
+	Client
+}
+
+// NewContextClient returns c as a ContextClient.
+//
+// If c already implements ContextClient it is returned unchanged, otherwise it's
+// wrapped so that SendContext returns ctx.Err() if ctx is done before the request
+// is sent. Once started the request can't be interrupted by the wrapped client.
+func NewContextClient(c Client) ContextClient {
+	if cc, ok := c.(ContextClient); ok {
+		return cc
+	}
+
+	return &contextClient{Client: c}
+}
+
+// SendContext implements ContextClient.SendContext.
+func (c *contextClient) SendContext(ctx context.Context, url string, message, response interface{}) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return c.Send(url, message, response)
+}
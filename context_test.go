@@ -0,0 +1,44 @@
+package slack
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// sendCounter is a Client which counts the calls to Send.
+type sendCounter struct {
+	calls int
+}
+
+func (c *sendCounter) Send(url string, message, response interface{}) error {
+	c.calls++
+	return nil
+}
+
+// ctxSendCounter is a ContextClient which counts the calls to SendContext.
+type ctxSendCounter struct {
+	sendCounter
+}
+
+func (c *ctxSendCounter) SendContext(ctx context.Context, url string, message, response interface{}) error {
+	return c.Send(url, message, response)
+}
+
+func TestNewContextClient(t *testing.T) {
+	c := &sendCounter{}
+	cc := NewContextClient(c)
+	assert.NoError(t, cc.SendContext(context.Background(), "", nil, nil))
+	assert.Equal(t, 1, c.calls)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(t, context.Canceled, cc.SendContext(ctx, "", nil, nil))
+	assert.Equal(t, 1, c.calls)
+}
+
+func TestNewContextClientPassthrough(t *testing.T) {
+	c := &ctxSendCounter{}
+	assert.Equal(t, ContextClient(c), NewContextClient(c))
+}
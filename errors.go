@@ -1,23 +1,148 @@
 package slack
 
 import (
+	"errors"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // Error represents an error from the Slack API.
 type Error struct {
 	// StatusCode is the status code returned by the request.
+	// When requests are retried this is the status code of the last attempt.
 	StatusCode int
 
 	// Message is the message, if any, returned in the body.
 	Message string
+
+	// Code is the slack error code, if Message is one.
+	Code ErrorCode
+
+	// Err is the underlying cause of the error, if any.
+	Err error
+
+	// Attempts is the number of attempts made before the request failed.
+	Attempts int
+
+	// RetryAfter is the delay requested by the Retry-After header, if any.
+	RetryAfter time.Duration
 }
 
 // NewError returns a new slack error with statuscode and msg.
+// If msg is a slack error code such as channel_not_found, Code is also set
+// so that errors.Is can be used to match it e.g.
+//
+//	errors.Is(err, slack.ErrChannelNotFound)
 func NewError(statuscode int, msg string) *Error {
-	return &Error{StatusCode: statuscode, Message: msg}
+	e := &Error{StatusCode: statuscode, Message: msg, Attempts: 1}
+	if c := strings.TrimSpace(msg); isErrorCode(c) {
+		e.Code = ErrorCode(c)
+	}
+
+	return e
+}
+
+// NewDecodeError returns a new slack error with statuscode for a response
+// body which couldn't be decoded due to err.
+// Its Err is a *DecodeError which distinguishes it from API level errors.
+func NewDecodeError(statuscode int, err error) *Error {
+	return &Error{StatusCode: statuscode, Message: err.Error(), Err: &DecodeError{Err: err}, Attempts: 1}
+}
+
+// NewHTTPError returns a new slack error for the unsuccessful response r.
+// The body of r is used as the message and the Retry-After header, if present, is recorded.
+func NewHTTPError(r *http.Response) *Error {
+	b, _ := ioutil.ReadAll(r.Body)
+	e := NewError(r.StatusCode, string(b))
+	e.RetryAfter = parseRetryAfter(r.Header.Get("Retry-After"))
+
+	return e
 }
 
 func (e *Error) Error() string {
+	if e.Attempts > 1 {
+		return fmt.Sprintf("slack: request failed statuscode: %v, message: %v, attempts: %v", e.StatusCode, e.Message, e.Attempts)
+	}
+
 	return fmt.Sprintf("slack: request failed statuscode: %v, message: %v", e.StatusCode, e.Message)
 }
+
+// Unwrap returns the underlying cause of the error.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is returns true if target is the ErrorCode of e.
+func (e *Error) Is(target error) bool {
+	c, ok := target.(ErrorCode)
+	return ok && c != "" && c == e.Code
+}
+
+// Retryable returns true if the request may succeed if retried.
+// This is the case for rate limited requests, server errors and retryable error codes.
+func (e *Error) Retryable() bool {
+	var de *DecodeError
+	if errors.As(e.Err, &de) {
+		return false
+	}
+
+	return e.StatusCode == http.StatusTooManyRequests ||
+		e.StatusCode >= http.StatusInternalServerError ||
+		e.Code.Retryable()
+}
+
+// Temporary is an alias for Retryable.
+func (e *Error) Temporary() bool {
+	return e.Retryable()
+}
+
+// IsRetryable returns true if err, or any error it wraps, reports itself as retryable.
+func IsRetryable(err error) bool {
+	var r interface {
+		Retryable() bool
+	}
+
+	return errors.As(err, &r) && r.Retryable()
+}
+
+// DecodeError is the cause of an Error when the response body couldn't be decoded.
+type DecodeError struct {
+	// Err is the error returned by the decoder.
+	Err error
+}
+
+func (e *DecodeError) Error() string {
+	return "slack: decode response: " + e.Err.Error()
+}
+
+// Unwrap returns the error returned by the decoder.
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// parseRetryAfter returns the delay specified by the Retry-After header value v.
+// It supports both delay-seconds and HTTP-date formats, returning 0 if v is invalid.
+func parseRetryAfter(v string) time.Duration {
+	if v == "" {
+		return 0
+	}
+
+	if s, err := strconv.Atoi(v); err == nil {
+		if s < 0 {
+			return 0
+		}
+		return time.Duration(s) * time.Second
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+
+	return 0
+}
@@ -1,8 +1,14 @@
 package slack
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
 	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -13,5 +19,90 @@ func TestNewError(t *testing.T) {
 	err := NewError(code, errStr)
 	assert.Error(t, err)
 	assert.Equal(t, code, err.StatusCode)
+	assert.Equal(t, 1, err.Attempts)
 	assert.Contains(t, err.Error(), "failed")
+	assert.NotContains(t, err.Error(), "attempts")
+
+	err.Attempts = 3
+	assert.Contains(t, err.Error(), "attempts: 3")
+}
+
+func TestNewHTTPError(t *testing.T) {
+	r := &http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"Retry-After": {"30"}},
+		Body:       ioutil.NopCloser(strings.NewReader("rate limited")),
+	}
+	err := NewHTTPError(r)
+	assert.Equal(t, http.StatusTooManyRequests, err.StatusCode)
+	assert.Equal(t, "rate limited", err.Message)
+	assert.Equal(t, 30*time.Second, err.RetryAfter)
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	assert.Equal(t, time.Duration(0), parseRetryAfter(""))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("invalid"))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("-1"))
+	assert.Equal(t, 2*time.Second, parseRetryAfter("2"))
+
+	d := parseRetryAfter(time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+	assert.True(t, d > 50*time.Second && d <= time.Minute, d)
+	assert.Equal(t, time.Duration(0), parseRetryAfter(time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat)))
+}
+
+func TestErrorIs(t *testing.T) {
+	err := NewError(http.StatusOK, "channel_not_found")
+	assert.Equal(t, ErrChannelNotFound, err.Code)
+	assert.True(t, errors.Is(err, ErrChannelNotFound))
+	assert.False(t, errors.Is(err, ErrInvalidAuth))
+
+	wrapped := fmt.Errorf("send: %w", err)
+	assert.True(t, errors.Is(wrapped, ErrChannelNotFound))
+
+	var serr *Error
+	assert.True(t, errors.As(wrapped, &serr))
+
+	err = NewError(http.StatusNotFound, "channel_not_found\n")
+	assert.True(t, errors.Is(err, ErrChannelNotFound))
+
+	err = NewError(http.StatusBadRequest, "my error")
+	assert.Equal(t, ErrorCode(""), err.Code)
+	assert.False(t, errors.Is(err, ErrorCode("")))
+}
+
+func TestNewDecodeError(t *testing.T) {
+	jerr := json.Unmarshal([]byte("invalid"), &struct{}{})
+	err := NewDecodeError(http.StatusOK, jerr)
+	assert.Equal(t, http.StatusOK, err.StatusCode)
+	assert.Equal(t, ErrorCode(""), err.Code)
+	assert.False(t, err.Retryable())
+
+	var de *DecodeError
+	if assert.True(t, errors.As(err, &de)) {
+		assert.Equal(t, jerr, de.Err)
+		assert.Contains(t, de.Error(), "decode")
+	}
+
+	var serr *json.SyntaxError
+	assert.True(t, errors.As(err, &serr))
+
+	assert.False(t, errors.As(NewError(http.StatusOK, "no_text"), &de))
+}
+
+func TestErrorRetryable(t *testing.T) {
+	assert.True(t, NewError(http.StatusTooManyRequests, "").Retryable())
+	assert.True(t, NewError(http.StatusServiceUnavailable, "").Retryable())
+	assert.True(t, NewError(http.StatusOK, "ratelimited").Retryable())
+	assert.True(t, NewError(http.StatusOK, "internal_error").Temporary())
+	assert.False(t, NewError(http.StatusOK, "channel_not_found").Retryable())
+	assert.False(t, NewError(http.StatusNotFound, "").Temporary())
+}
+
+func TestIsRetryable(t *testing.T) {
+	assert.True(t, IsRetryable(NewError(http.StatusTooManyRequests, "")))
+	assert.True(t, IsRetryable(fmt.Errorf("send: %w", NewError(http.StatusBadGateway, ""))))
+	assert.True(t, IsRetryable(ErrRateLimited))
+	assert.False(t, IsRetryable(ErrInvalidAuth))
+	assert.False(t, IsRetryable(errors.New("my error")))
+	assert.False(t, IsRetryable(nil))
 }
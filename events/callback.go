@@ -0,0 +1,70 @@
+package events
+
+import (
+	"encoding/json"
+)
+
+// Authorization is an installation of the app which can see an event.
+type Authorization struct {
+	EnterpriseID        string `json:"enterprise_id,omitempty"`
+	TeamID              string `json:"team_id"`
+	UserID              string `json:"user_id"`
+	IsBot               bool   `json:"is_bot"`
+	IsEnterpriseInstall bool   `json:"is_enterprise_install,omitempty"`
+}
+
+// Callback is an event_callback envelope which delivers an event.
+//
+// See: https://api.slack.com/apis/connections/events-api#callback-field
+type Callback struct {
+	// TeamID is the ID of the workspace the event occurred in.
+	TeamID string `json:"team_id"`
+
+	// APIAppID is the ID of the app the event is for.
+	APIAppID string `json:"api_app_id"`
+
+	// EventID is the unique ID of the event, which is the same for retries.
+	EventID string `json:"event_id"`
+
+	// EventTime is the unix time the event occurred.
+	EventTime int64 `json:"event_time"`
+
+	// EventContext identifies the event across installations.
+	EventContext string `json:"event_context,omitempty"`
+
+	// Authorizations are the installations of the app which can see the event.
+	Authorizations []Authorization `json:"authorizations,omitempty"`
+
+	// IsExtSharedChannel indicates if the event occurred in a channel shared with another organisation.
+	IsExtSharedChannel bool `json:"is_ext_shared_channel,omitempty"`
+
+	// Event is the event decoded according to its type. Events of types
+	// which aren't modelled are decoded as *Unknown.
+	Event Event `json:"-"`
+
+	// RawEvent is the JSON encoding of the event.
+	RawEvent json.RawMessage `json:"event"`
+
+	// RetryNum is the number of the delivery attempt if the event is being
+	// retried, otherwise 0.
+	RetryNum int `json:"-"`
+
+	// RetryReason is the reason the event is being retried e.g. http_timeout.
+	RetryReason string `json:"-"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (cb *Callback) UnmarshalJSON(data []byte) error {
+	type alias Callback
+	if err := json.Unmarshal(data, (*alias)(cb)); err != nil {
+		return err
+	}
+
+	e, err := decodeEvent(cb.RawEvent)
+	if err != nil {
+		return err
+	}
+	cb.Event = e
+
+	return nil
+}
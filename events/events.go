@@ -0,0 +1,336 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/multiplay/go-slack/chat"
+)
+
+// eventTypes maps event types to a function which creates an event of that type.
+var eventTypes = map[string]func() Event{
+	"message":               func() Event { return &Message{} },
+	"app_mention":           func() Event { return &AppMention{} },
+	"app_home_opened":       func() Event { return &AppHomeOpened{} },
+	"reaction_added":        func() Event { return &ReactionAdded{} },
+	"reaction_removed":      func() Event { return &ReactionRemoved{} },
+	"member_joined_channel": func() Event { return &MemberJoinedChannel{} },
+	"member_left_channel":   func() Event { return &MemberLeftChannel{} },
+	"link_shared":           func() Event { return &LinkShared{} },
+}
+
+// Event is an event delivered in an event callback.
+type Event interface {
+	// EventType returns the type of the event e.g. message.
+	EventType() string
+}
+
+// decodeEvent decodes the JSON object data into a new event created by the
+// entry in eventTypes matching its "type" field. Events of types which
+// aren't modelled are decoded as *Unknown.
+func decodeEvent(data []byte) (Event, error) {
+	var t struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &t); err != nil {
+		return nil, err
+	}
+
+	f, ok := eventTypes[t.Type]
+	if !ok {
+		return &Unknown{Type: t.Type, Raw: append(json.RawMessage(nil), data...)}, nil
+	}
+
+	e := f()
+	if err := json.Unmarshal(data, e); err != nil {
+		return nil, fmt.Errorf("events: decode %v event: %w", t.Type, err)
+	}
+
+	return e, nil
+}
+
+// Unknown is an event of a type which isn't modelled.
+type Unknown struct {
+	// Type is the type of the event.
+	Type string
+
+	// Raw is the JSON encoding of the event.
+	Raw json.RawMessage
+}
+
+// EventType implements Event.
+func (e Unknown) EventType() string {
+	return e.Type
+}
+
+// Message is sent when a message is posted to a channel the app is in.
+// Subtype identifies messages such as edits and bot messages.
+//
+// See: https://api.slack.com/events/message
+type Message struct {
+	// Subtype is the subtype of the message e.g. bot_message or empty for a plain message.
+	Subtype string `json:"subtype,omitempty"`
+
+	// Channel is the ID of the channel the message was posted to.
+	Channel string `json:"channel"`
+
+	// ChannelType is the type of the channel e.g. channel, group, im or mpim.
+	ChannelType string `json:"channel_type,omitempty"`
+
+	// User is the ID of the user who posted the message.
+	User string `json:"user,omitempty"`
+
+	// BotID is the ID of the bot which posted the message, if posted by a bot.
+	BotID string `json:"bot_id,omitempty"`
+
+	// Text is the text of the message.
+	Text string `json:"text"`
+
+	// Blocks are the Block Kit layout blocks of the message.
+	Blocks chat.Blocks `json:"blocks,omitempty"`
+
+	// Attachments are the attachments of the message.
+	Attachments []*chat.Attachment `json:"attachments,omitempty"`
+
+	// Timestamp is the timestamp (ts) of the message.
+	Timestamp string `json:"ts"`
+
+	// ThreadTS is the timestamp of the thread's parent message if the
+	// message is in a thread.
+	ThreadTS string `json:"thread_ts,omitempty"`
+
+	// EventTS is the timestamp of the event.
+	EventTS string `json:"event_ts,omitempty"`
+}
+
+// EventType implements Event.
+func (Message) EventType() string {
+	return "message"
+}
+
+// AppMention is sent when the app is mentioned in a message.
+//
+// See: https://api.slack.com/events/app_mention
+type AppMention struct {
+	// Channel is the ID of the channel the message was posted to.
+	Channel string `json:"channel"`
+
+	// User is the ID of the user who mentioned the app.
+	User string `json:"user"`
+
+	// Text is the text of the message.
+	Text string `json:"text"`
+
+	// Blocks are the Block Kit layout blocks of the message.
+	Blocks chat.Blocks `json:"blocks,omitempty"`
+
+	// Timestamp is the timestamp (ts) of the message.
+	Timestamp string `json:"ts"`
+
+	// ThreadTS is the timestamp of the thread's parent message if the
+	// message is in a thread.
+	ThreadTS string `json:"thread_ts,omitempty"`
+
+	// EventTS is the timestamp of the event.
+	EventTS string `json:"event_ts,omitempty"`
+}
+
+// EventType implements Event.
+func (AppMention) EventType() string {
+	return "app_mention"
+}
+
+// AppHomeOpened is sent when a user opens the app's App Home.
+//
+// See: https://api.slack.com/events/app_home_opened
+type AppHomeOpened struct {
+	// User is the ID of the user who opened the App Home.
+	User string `json:"user"`
+
+	// Channel is the ID of the app's direct message channel with the user.
+	Channel string `json:"channel"`
+
+	// Tab is the tab opened, home or messages.
+	Tab string `json:"tab"`
+
+	// EventTS is the timestamp of the event.
+	EventTS string `json:"event_ts,omitempty"`
+}
+
+// EventType implements Event.
+func (AppHomeOpened) EventType() string {
+	return "app_home_opened"
+}
+
+// ReactionItem is the item a reaction was added to or removed from.
+type ReactionItem struct {
+	// Type is the type of the item e.g. message or file.
+	Type string `json:"type"`
+
+	// Channel is the ID of the channel containing the message.
+	Channel string `json:"channel,omitempty"`
+
+	// Timestamp is the timestamp (ts) of the message.
+	Timestamp string `json:"ts,omitempty"`
+
+	// File is the ID of the file.
+	File string `json:"file,omitempty"`
+}
+
+// ReactionAdded is sent when a user adds a reaction to an item.
+//
+// See: https://api.slack.com/events/reaction_added
+type ReactionAdded struct {
+	// User is the ID of the user who added the reaction.
+	User string `json:"user"`
+
+	// Reaction is the name of the emoji e.g. thumbsup.
+	Reaction string `json:"reaction"`
+
+	// ItemUser is the ID of the user who created the item.
+	ItemUser string `json:"item_user,omitempty"`
+
+	// Item is the item the reaction was added to.
+	Item ReactionItem `json:"item"`
+
+	// EventTS is the timestamp of the event.
+	EventTS string `json:"event_ts,omitempty"`
+}
+
+// EventType implements Event.
+func (ReactionAdded) EventType() string {
+	return "reaction_added"
+}
+
+// ReactionRemoved is sent when a user removes a reaction from an item.
+//
+// See: https://api.slack.com/events/reaction_removed
+type ReactionRemoved struct {
+	// User is the ID of the user who removed the reaction.
+	User string `json:"user"`
+
+	// Reaction is the name of the emoji e.g. thumbsup.
+	Reaction string `json:"reaction"`
+
+	// ItemUser is the ID of the user who created the item.
+	ItemUser string `json:"item_user,omitempty"`
+
+	// Item is the item the reaction was removed from.
+	Item ReactionItem `json:"item"`
+
+	// EventTS is the timestamp of the event.
+	EventTS string `json:"event_ts,omitempty"`
+}
+
+// EventType implements Event.
+func (ReactionRemoved) EventType() string {
+	return "reaction_removed"
+}
+
+// MemberJoinedChannel is sent when a user joins a channel.
+//
+// See: https://api.slack.com/events/member_joined_channel
+type MemberJoinedChannel struct {
+	// User is the ID of the user who joined.
+	User string `json:"user"`
+
+	// Channel is the ID of the channel joined.
+	Channel string `json:"channel"`
+
+	// ChannelType is the type of the channel, C for public or G for private.
+	ChannelType string `json:"channel_type,omitempty"`
+
+	// Team is the ID of the team of the user.
+	Team string `json:"team,omitempty"`
+
+	// Inviter is the ID of the user who invited the user, if they were invited.
+	Inviter string `json:"inviter,omitempty"`
+
+	// EventTS is the timestamp of the event.
+	EventTS string `json:"event_ts,omitempty"`
+}
+
+// EventType implements Event.
+func (MemberJoinedChannel) EventType() string {
+	return "member_joined_channel"
+}
+
+// MemberLeftChannel is sent when a user leaves a channel.
+//
+// See: https://api.slack.com/events/member_left_channel
+type MemberLeftChannel struct {
+	// User is the ID of the user who left.
+	User string `json:"user"`
+
+	// Channel is the ID of the channel left.
+	Channel string `json:"channel"`
+
+	// ChannelType is the type of the channel, C for public or G for private.
+	ChannelType string `json:"channel_type,omitempty"`
+
+	// Team is the ID of the team of the user.
+	Team string `json:"team,omitempty"`
+
+	// EventTS is the timestamp of the event.
+	EventTS string `json:"event_ts,omitempty"`
+}
+
+// EventType implements Event.
+func (MemberLeftChannel) EventType() string {
+	return "member_left_channel"
+}
+
+// Link is a link in a message.
+type Link struct {
+	// Domain is the domain of the link.
+	Domain string `json:"domain"`
+
+	// URL is the URL of the link.
+	URL string `json:"url"`
+}
+
+// LinkShared is sent when a message contains a link to a domain registered
+// by the app. Reply to it with a chat.Unfurl to provide unfurls.
+//
+// See: https://api.slack.com/events/link_shared
+type LinkShared struct {
+	// Channel is the ID of the channel the message was posted to.
+	Channel string `json:"channel"`
+
+	// User is the ID of the user who posted the message.
+	User string `json:"user"`
+
+	// MessageTimestamp is the timestamp (ts) of the message.
+	MessageTimestamp string `json:"message_ts"`
+
+	// ThreadTS is the timestamp of the thread's parent message if the
+	// message is in a thread.
+	ThreadTS string `json:"thread_ts,omitempty"`
+
+	// Links are the links in the message to the app's domains.
+	Links []Link `json:"links"`
+
+	// UnfurlID identifies the unfurl when Source is composer.
+	UnfurlID string `json:"unfurl_id,omitempty"`
+
+	// Source is where the link was shared, composer or conversations_history.
+	Source string `json:"source,omitempty"`
+
+	// EventTS is the timestamp of the event.
+	EventTS string `json:"event_ts,omitempty"`
+}
+
+// EventType implements Event.
+func (LinkShared) EventType() string {
+	return "link_shared"
+}
+
+// Unfurl returns a chat.Unfurl which provides unfurls for the links of the event.
+func (e *LinkShared) Unfurl() *chat.Unfurl {
+	return &chat.Unfurl{
+		Channel:   e.Channel,
+		Timestamp: e.MessageTimestamp,
+		UnfurlID:  e.UnfurlID,
+		Source:    e.Source,
+	}
+}
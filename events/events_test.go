@@ -0,0 +1,66 @@
+package events
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/multiplay/go-slack/chat"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeEvent(t *testing.T) {
+	tests := map[string]Event{
+		`{"type":"message","channel":"C123","user":"U123","text":"hi","ts":"1.000001","channel_type":"channel","blocks":[{"type":"divider"}]}`: &Message{
+			Channel: "C123", User: "U123", Text: "hi", Timestamp: "1.000001", ChannelType: "channel", Blocks: chat.Blocks{&chat.DividerBlock{}},
+		},
+		`{"type":"message","subtype":"bot_message","bot_id":"B123","text":"hi","ts":"1.000001","thread_ts":"1.000000"}`: &Message{
+			Subtype: "bot_message", BotID: "B123", Text: "hi", Timestamp: "1.000001", ThreadTS: "1.000000",
+		},
+		`{"type":"app_home_opened","user":"U123","channel":"D123","tab":"home"}`: &AppHomeOpened{
+			User: "U123", Channel: "D123", Tab: "home",
+		},
+		`{"type":"reaction_added","user":"U123","reaction":"thumbsup","item_user":"U456","item":{"type":"message","channel":"C123","ts":"1.000001"}}`: &ReactionAdded{
+			User: "U123", Reaction: "thumbsup", ItemUser: "U456", Item: ReactionItem{Type: "message", Channel: "C123", Timestamp: "1.000001"},
+		},
+		`{"type":"reaction_removed","user":"U123","reaction":"thumbsup","item":{"type":"file","file":"F123"}}`: &ReactionRemoved{
+			User: "U123", Reaction: "thumbsup", Item: ReactionItem{Type: "file", File: "F123"},
+		},
+		`{"type":"member_joined_channel","user":"U123","channel":"C123","channel_type":"C","team":"T123","inviter":"U456"}`: &MemberJoinedChannel{
+			User: "U123", Channel: "C123", ChannelType: "C", Team: "T123", Inviter: "U456",
+		},
+		`{"type":"member_left_channel","user":"U123","channel":"C123","channel_type":"G"}`: &MemberLeftChannel{
+			User: "U123", Channel: "C123", ChannelType: "G",
+		},
+		`{"type":"channel_rename","channel":{"id":"C123"}}`: &Unknown{
+			Type: "channel_rename", Raw: json.RawMessage(`{"type":"channel_rename","channel":{"id":"C123"}}`),
+		},
+	}
+
+	for data, expected := range tests {
+		e, err := decodeEvent([]byte(data))
+		if assert.NoError(t, err, data) {
+			assert.Equal(t, expected, e, data)
+		}
+	}
+
+	_, err := decodeEvent([]byte(`[]`))
+	assert.Error(t, err)
+}
+
+func TestLinkSharedUnfurl(t *testing.T) {
+	data := `{"type":"link_shared","channel":"C123","user":"U123","message_ts":"1.000001","links":[{"domain":"wiki.internal","url":"https://wiki.internal/page"}],"source":"conversations_history"}`
+	e, err := decodeEvent([]byte(data))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	ls, ok := e.(*LinkShared)
+	if !assert.True(t, ok) || !assert.Len(t, ls.Links, 1) {
+		return
+	}
+	assert.Equal(t, "https://wiki.internal/page", ls.Links[0].URL)
+
+	u := ls.Unfurl()
+	assert.Equal(t, &chat.Unfurl{Channel: "C123", Timestamp: "1.000001", Source: "conversations_history"}, u)
+}
@@ -0,0 +1,173 @@
+// Package events provides an http.Handler which receives events from the
+// slack Events API.
+//
+// Requests are verified using the app's signing secret with the signature
+// package, url_verification challenges are answered automatically and event
+// callbacks are decoded into typed events and passed to the handler
+// registered for their type e.g.
+//
+//	h := events.New(events.Config{SigningSecret: secret})
+//	events.On(h, func(ctx context.Context, cb *events.Callback, e *events.AppMention) error {
+//		...
+//	})
+//	http.Handle("/slack/events", h)
+//
+// See: https://api.slack.com/apis/connections/events-api
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/multiplay/go-slack/signature"
+)
+
+const (
+	// RetryNumHeader is the header containing the number of a retried delivery.
+	RetryNumHeader = "X-Slack-Retry-Num"
+
+	// RetryReasonHeader is the header containing the reason for a retried delivery.
+	RetryReasonHeader = "X-Slack-Retry-Reason"
+)
+
+// Config is the configuration of a Handler.
+type Config struct {
+	// SigningSecret is the app's signing secret used to verify requests.
+	// If it's empty all requests are rejected.
+	SigningSecret string
+
+	// MaxAge is the maximum difference between a request's timestamp and
+	// the current time, after which it's rejected to prevent replay attacks.
+	// Defaults to signature.DefaultMaxAge.
+	MaxAge time.Duration
+}
+
+// SetConfigDefaults sets defaults on the configuration if needed to ensure the cfg is valid.
+func SetConfigDefaults(cfg *Config) {
+	if cfg.MaxAge == 0 {
+		cfg.MaxAge = signature.DefaultMaxAge
+	}
+}
+
+// HandlerFunc handles an event callback.
+// If it returns an error the request fails with http.StatusInternalServerError
+// so slack will retry the delivery.
+type HandlerFunc func(ctx context.Context, cb *Callback) error
+
+// Handler is an http.Handler which receives events from the slack Events API.
+type Handler struct {
+	Config
+	handler http.Handler
+
+	mtx      sync.RWMutex
+	handlers map[string]HandlerFunc
+}
+
+// New returns a new Handler with the given configuration.
+// It ensures that the cfg is valid by calling SetConfigDefaults on the cfg.
+func New(cfg Config) *Handler {
+	SetConfigDefaults(&cfg)
+
+	h := &Handler{Config: cfg, handlers: make(map[string]HandlerFunc)}
+	v := signature.NewVerifier(signature.Config{SigningSecret: cfg.SigningSecret, MaxAge: cfg.MaxAge})
+	h.handler = v.Middleware(http.HandlerFunc(h.serve))
+
+	return h
+}
+
+// Handle registers fn as the handler for events of eventType e.g. message,
+// replacing any existing handler.
+// Callbacks for events without a handler are acknowledged and ignored.
+func (h *Handler) Handle(eventType string, fn HandlerFunc) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	h.handlers[eventType] = fn
+}
+
+// On registers fn as the handler for events of the type of E, passing it
+// the event as an E e.g.
+//
+//	events.On(h, func(ctx context.Context, cb *events.Callback, e *events.ReactionAdded) error {
+//		...
+//	})
+func On[T any, E interface {
+	*T
+	Event
+}](h *Handler, fn func(ctx context.Context, cb *Callback, e E) error) {
+	h.Handle(E(new(T)).EventType(), func(ctx context.Context, cb *Callback) error {
+		e, ok := cb.Event.(E)
+		if !ok {
+			return nil
+		}
+		return fn(ctx, cb, e)
+	})
+}
+
+// ServeHTTP implements http.Handler.
+// Requests which fail verification are rejected with http.StatusUnauthorized.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	h.handler.ServeHTTP(w, r)
+}
+
+// serve handles a verified request.
+func (h *Handler) serve(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var env struct {
+		Type      string `json:"type"`
+		Challenge string `json:"challenge"`
+	}
+	if err := json.Unmarshal(body, &env); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	switch env.Type {
+	case "url_verification":
+		w.Header().Set("Content-Type", "text/plain")
+		io.WriteString(w, env.Challenge)
+	case "event_callback":
+		cb := &Callback{}
+		if err := json.Unmarshal(body, cb); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		cb.RetryNum, _ = strconv.Atoi(r.Header.Get(RetryNumHeader))
+		cb.RetryReason = r.Header.Get(RetryReasonHeader)
+
+		if err := h.dispatch(r.Context(), cb); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	// Other envelopes such as app_rate_limited are acknowledged.
+}
+
+// dispatch calls the handler registered for the event of cb if any.
+func (h *Handler) dispatch(ctx context.Context, cb *Callback) error {
+	h.mtx.RLock()
+	fn, ok := h.handlers[cb.Event.EventType()]
+	h.mtx.RUnlock()
+	if !ok {
+		return nil
+	}
+
+	return fn(ctx, cb)
+}
@@ -0,0 +1,206 @@
+package events_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/multiplay/go-slack/events"
+	"github.com/multiplay/go-slack/signature"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const secret = "8f742231b10e8888abcd99yyyzzz85a5"
+
+// post sends body to h signed with key at ts and returns the response.
+func post(h http.Handler, key string, ts time.Time, body string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodPost, "/slack/events", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	signature.NewSigner(key).SignAt(r, ts)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	return w
+}
+
+const mention = `{
+	"token":"XXYYZZ",
+	"team_id":"T123",
+	"api_app_id":"A123",
+	"event":{
+		"type":"app_mention",
+		"user":"U123",
+		"text":"<@U0LAN0Z89> is it everything a river should be?",
+		"ts":"1515449522.000016",
+		"channel":"C123",
+		"event_ts":"1515449522000016"
+	},
+	"type":"event_callback",
+	"event_id":"Ev0LAN670R",
+	"event_time":1515449522,
+	"authorizations":[{"team_id":"T123","user_id":"U0LAN0Z89","is_bot":true}]
+}`
+
+func TestURLVerification(t *testing.T) {
+	h := New(Config{SigningSecret: secret})
+	w := post(h, secret, time.Now(), `{"token":"Jhj5dZrVaK7ZwHHjRyZWjbDl","challenge":"3eZbrw1aBm2rZgRNFdxV2595E9CY3gmdALWMmHkvFXO7tYXAYM8P","type":"url_verification"}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "3eZbrw1aBm2rZgRNFdxV2595E9CY3gmdALWMmHkvFXO7tYXAYM8P", w.Body.String())
+	assert.Equal(t, "text/plain", w.Header().Get("Content-Type"))
+}
+
+func TestOn(t *testing.T) {
+	h := New(Config{SigningSecret: secret})
+	var got *AppMention
+	var gotCB *Callback
+	On(h, func(ctx context.Context, cb *Callback, e *AppMention) error {
+		gotCB, got = cb, e
+		return nil
+	})
+
+	w := post(h, secret, time.Now(), mention)
+	assert.Equal(t, http.StatusOK, w.Code)
+	if assert.NotNil(t, got) {
+		assert.Equal(t, "U123", got.User)
+		assert.Equal(t, "C123", got.Channel)
+		assert.Equal(t, "1515449522.000016", got.Timestamp)
+	}
+	if assert.NotNil(t, gotCB) {
+		assert.Equal(t, "Ev0LAN670R", gotCB.EventID)
+		assert.Equal(t, "T123", gotCB.TeamID)
+		assert.Equal(t, 0, gotCB.RetryNum)
+		if assert.Len(t, gotCB.Authorizations, 1) {
+			assert.True(t, gotCB.Authorizations[0].IsBot)
+		}
+	}
+}
+
+func TestHandle(t *testing.T) {
+	h := New(Config{SigningSecret: secret})
+	var types []string
+	h.Handle("app_mention", func(ctx context.Context, cb *Callback) error {
+		types = append(types, cb.Event.EventType())
+		return nil
+	})
+	h.Handle("workflow_step_execute", func(ctx context.Context, cb *Callback) error {
+		if u, ok := cb.Event.(*Unknown); assert.True(t, ok) {
+			assert.Contains(t, string(u.Raw), "workflow_step")
+		}
+		types = append(types, cb.Event.EventType())
+		return nil
+	})
+
+	post(h, secret, time.Now(), mention)
+	post(h, secret, time.Now(), `{"type":"event_callback","event_id":"Ev1","event":{"type":"workflow_step_execute","workflow_step":{}}}`)
+
+	// Events without a handler are acknowledged.
+	w := post(h, secret, time.Now(), `{"type":"event_callback","event_id":"Ev2","event":{"type":"reaction_added","user":"U123","reaction":"+1","item":{"type":"message","channel":"C123","ts":"1.000000"}}}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	// As are other envelope types.
+	w = post(h, secret, time.Now(), `{"type":"app_rate_limited","minute_rate_limited":1518467820}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	assert.Equal(t, []string{"app_mention", "workflow_step_execute"}, types)
+}
+
+func TestHandlerError(t *testing.T) {
+	h := New(Config{SigningSecret: secret})
+	var retry int
+	var reason string
+	On(h, func(ctx context.Context, cb *Callback, e *AppMention) error {
+		retry, reason = cb.RetryNum, cb.RetryReason
+		return errors.New("database unavailable")
+	})
+
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(mention))
+	if !assert.NoError(t, signature.NewSigner(secret).Sign(r)) {
+		return
+	}
+	r.Header.Set(RetryNumHeader, "2")
+	r.Header.Set(RetryReasonHeader, "http_error")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Contains(t, w.Body.String(), "database unavailable")
+	assert.Equal(t, 2, retry)
+	assert.Equal(t, "http_error", reason)
+}
+
+func TestVerify(t *testing.T) {
+	h := New(Config{SigningSecret: secret})
+	called := false
+	On(h, func(ctx context.Context, cb *Callback, e *AppMention) error {
+		called = true
+		return nil
+	})
+
+	tests := map[string]struct {
+		key string
+		ts  time.Time
+	}{
+		"wrong secret": {key: "wrong", ts: time.Now()},
+		"too old":      {key: secret, ts: time.Now().Add(-signature.DefaultMaxAge - time.Minute)},
+		"future":       {key: secret, ts: time.Now().Add(signature.DefaultMaxAge + time.Minute)},
+	}
+	for name, tc := range tests {
+		w := post(h, tc.key, tc.ts, mention)
+		assert.Equal(t, http.StatusUnauthorized, w.Code, name)
+	}
+
+	// Modified body.
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(mention+" "))
+	now := time.Now()
+	r.Header.Set(signature.TimestampHeader, strconv.FormatInt(now.Unix(), 10))
+	r.Header.Set(signature.SignatureHeader, signature.Sign(secret, now, []byte(mention)))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Contains(t, w.Body.String(), signature.ErrInvalidSignature.Error())
+
+	// Missing headers.
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(mention)))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	assert.False(t, called)
+
+	// A handler without a signing secret rejects all requests.
+	h = New(Config{})
+	On(h, func(ctx context.Context, cb *Callback, e *AppMention) error {
+		called = true
+		return nil
+	})
+	w = post(h, "", time.Now(), mention)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.False(t, called)
+
+	// A larger replay window accepts older requests.
+	h = New(Config{SigningSecret: secret, MaxAge: time.Hour})
+	w = post(h, secret, time.Now().Add(-30*time.Minute), mention)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestBadRequests(t *testing.T) {
+	h := New(Config{SigningSecret: secret})
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+
+	w = post(h, secret, time.Now(), `not json`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = post(h, secret, time.Now(), `{"type":"event_callback","event":{"type":"message","text":1}}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = post(h, secret, time.Now(), `{"type":"event_callback"}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,102 @@
+package slack
+
+import (
+	"net/http"
+	"time"
+)
+
+// HTTPConfig is the HTTP configuration used by clients to make requests.
+type HTTPConfig struct {
+	// HTTPClient is the client used to make requests.
+	// If nil http.DefaultClient is used.
+	HTTPClient *http.Client
+
+	// UserAgent if set is sent as the User-Agent header of each request.
+	UserAgent string
+
+	// Header contains additional headers which are sent with each request.
+	// They replace any headers of the same name set by the client.
+	Header http.Header
+}
+
+// Option is a function which configures a HTTPConfig.
+type Option func(*HTTPConfig)
+
+// WithHTTPClient returns an Option which sets the http.Client used to make requests.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *HTTPConfig) {
+		c.HTTPClient = hc
+	}
+}
+
+// WithTransport returns an Option which sets the http.RoundTripper used to make requests.
+// Any http.Client previously configured is copied and not modified.
+func WithTransport(rt http.RoundTripper) Option {
+	return func(c *HTTPConfig) {
+		c.HTTPClient = c.cloneClient()
+		c.HTTPClient.Transport = rt
+	}
+}
+
+// WithTimeout returns an Option which sets the time limit for requests.
+// Any http.Client previously configured is copied and not modified.
+func WithTimeout(d time.Duration) Option {
+	return func(c *HTTPConfig) {
+		c.HTTPClient = c.cloneClient()
+		c.HTTPClient.Timeout = d
+	}
+}
+
+// WithUserAgent returns an Option which sets the User-Agent header of requests.
+func WithUserAgent(ua string) Option {
+	return func(c *HTTPConfig) {
+		c.UserAgent = ua
+	}
+}
+
+// WithHeader returns an Option which adds the header key with value to requests.
+func WithHeader(key, value string) Option {
+	return func(c *HTTPConfig) {
+		if c.Header == nil {
+			c.Header = make(http.Header)
+		}
+		c.Header.Add(key, value)
+	}
+}
+
+// Apply applies opts to the configuration.
+func (c *HTTPConfig) Apply(opts ...Option) {
+	for _, o := range opts {
+		o(c)
+	}
+}
+
+// Do sends req with the configured User-Agent and Header using the configured HTTPClient.
+func (c *HTTPConfig) Do(req *http.Request) (*http.Response, error) {
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+	for k, vs := range c.Header {
+		req.Header.Del(k)
+		for _, v := range vs {
+			req.Header.Add(k, v)
+		}
+	}
+
+	hc := c.HTTPClient
+	if hc == nil {
+		hc = http.DefaultClient
+	}
+
+	return hc.Do(req)
+}
+
+// cloneClient returns a copy of the configured HTTPClient or a new http.Client if not set.
+func (c *HTTPConfig) cloneClient() *http.Client {
+	if c.HTTPClient == nil {
+		return &http.Client{}
+	}
+
+	hc := *c.HTTPClient
+	return &hc
+}
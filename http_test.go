@@ -0,0 +1,69 @@
+package slack
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// roundTripFunc is a http.RoundTripper implemented by a function.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestHTTPConfigApply(t *testing.T) {
+	hc := &http.Client{Timeout: time.Second}
+	rt := roundTripFunc(func(r *http.Request) (*http.Response, error) { return nil, nil })
+
+	c := &HTTPConfig{}
+	c.Apply(
+		WithHTTPClient(hc),
+		WithTransport(rt),
+		WithTimeout(time.Minute),
+		WithUserAgent("my-agent"),
+		WithHeader("X-Test", "1"),
+		WithHeader("X-Test", "2"),
+	)
+
+	if !assert.NotNil(t, c.HTTPClient) {
+		return
+	}
+	assert.NotEqual(t, hc, c.HTTPClient)
+	assert.Equal(t, time.Second, hc.Timeout)
+	assert.Nil(t, hc.Transport)
+	assert.Equal(t, time.Minute, c.HTTPClient.Timeout)
+	assert.NotNil(t, c.HTTPClient.Transport)
+	assert.Equal(t, "my-agent", c.UserAgent)
+	assert.Equal(t, []string{"1", "2"}, c.Header["X-Test"])
+}
+
+func TestHTTPConfigDo(t *testing.T) {
+	var req *http.Request
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req = r
+	}))
+	defer s.Close()
+
+	c := &HTTPConfig{}
+	c.Apply(WithUserAgent("my-agent"), WithHeader("Content-Type", "text/plain"))
+
+	r, err := http.NewRequest(http.MethodPost, s.URL, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	r.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.Do(r)
+	if !assert.NoError(t, err) {
+		return
+	}
+	resp.Body.Close()
+
+	assert.Equal(t, "my-agent", req.Header.Get("User-Agent"))
+	assert.Equal(t, "text/plain", req.Header.Get("Content-Type"))
+}
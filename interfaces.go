@@ -1,15 +1,27 @@
 // Package slack provides a generic interface for slack clients
 // and some basic types to enable the creation of slack clients.
 //
-// See the webhook sub directory for an example of such a client.
+// See the webhook and api sub directories for examples of such clients.
 package slack
 
+import (
+	"context"
+)
+
 // Client represents a slack client.
 type Client interface {
 	// Send sends the request to slack.
 	Send(url string, message, response interface{}) error
 }
 
+// ContextClient represents a slack client which supports cancellation and deadlines.
+type ContextClient interface {
+	Client
+
+	// SendContext sends the request to slack using ctx to control its lifetime.
+	SendContext(ctx context.Context, url string, message, response interface{}) error
+}
+
 // SendResponse is the interface that responses implement.
 type SendResponse interface {
 	Ok() bool
@@ -8,9 +8,12 @@ package lrhook
 
 import (
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/multiplay/go-slack"
 	"github.com/multiplay/go-slack/chat"
+	"github.com/multiplay/go-slack/mrkdwn"
 	"github.com/multiplay/go-slack/webhook"
 
 	"github.com/sirupsen/logrus"
@@ -31,6 +34,9 @@ var (
 
 	// DefaultUnknownColor is the default UnknownColor if one is not present in the configuration.
 	DefaultUnknownColor = "warning"
+
+	// DefaultThreadTTL is the default ThreadTTL if one is not present in the configuration.
+	DefaultThreadTTL = time.Hour
 )
 
 // Config is the configuration of a slack logrus.Hook.
@@ -62,10 +68,26 @@ type Config struct {
 	Message chat.Message
 
 	// Attachment defines the details of the attachment sent from the hook.
-	// Field Text - Will be set to that of log entry Message.
+	// Field Text - Will be set to that of log entry Message, escaped for mrkdwn.
 	// Field Fields - Will be created to match the log entry Fields.
 	// Field Color - Will be set according to the LevelColors or UnknownColor if a match is not found..
 	Attachment chat.Attachment
+
+	// Fit if not nil ensures messages fit within the limits of slack, such as
+	// when the log entry includes a stack trace, by truncating or splitting
+	// them as configured.
+	Fit *chat.FitConfig
+
+	// ThreadKey if set groups log entries which have the same value for the
+	// field ThreadKey as replies in the thread of the message of the first
+	// such entry. Entries without the field are posted to the channel.
+	// Requires a client whose responses include the message timestamp such
+	// as the api client, otherwise every entry is posted to the channel.
+	ThreadKey string
+
+	// ThreadTTL is how long a thread is used for after its last entry, after
+	// which the next entry with the same ThreadKey value starts a new thread.
+	ThreadTTL time.Duration
 }
 
 // Hook is a logrus hook that sends messages to Slack.
@@ -73,6 +95,16 @@ type Hook struct {
 	Config
 	client  slack.Client
 	limiter *rate.Limiter
+
+	mtx     sync.Mutex
+	threads map[string]*thread
+}
+
+// thread is the slack thread which log entries with the same ThreadKey value are grouped in.
+type thread struct {
+	mtx    sync.Mutex
+	thread *chat.Thread
+	last   time.Time
 }
 
 // SetConfigDefaults sets defaults on the configuration if needed to ensure the cfg is valid.
@@ -83,12 +115,16 @@ func SetConfigDefaults(cfg *Config) {
 	if cfg.UnknownColor == "" {
 		cfg.UnknownColor = DefaultUnknownColor
 	}
+	if cfg.ThreadTTL == 0 {
+		cfg.ThreadTTL = DefaultThreadTTL
+	}
 }
 
 // New returns a new Hook with the given configuration that posts messages using the webhook URL.
+// The webhook client is configured using opts.
 // It ensures that the cfg is valid by calling SetConfigDefaults on the cfg.
-func New(cfg Config, url string) *Hook {
-	return NewClient(cfg, webhook.New(url))
+func New(cfg Config, url string, opts ...slack.Option) *Hook {
+	return NewClient(cfg, webhook.New(url, opts...))
 }
 
 // NewClient returns a new Hook with the given configuration using the slack.Client c.
@@ -96,7 +132,7 @@ func New(cfg Config, url string) *Hook {
 func NewClient(cfg Config, client slack.Client) *Hook {
 	SetConfigDefaults(&cfg)
 
-	c := &Hook{Config: cfg, client: client}
+	c := &Hook{Config: cfg, client: client, threads: make(map[string]*thread)}
 	if cfg.Limit != 0 {
 		c.limiter = rate.NewLimiter(cfg.Limit, cfg.Burst)
 	}
@@ -134,16 +170,87 @@ func (sh *Hook) Fire(e *logrus.Entry) error {
 	if a.Color == "" {
 		a.Color = sh.UnknownColor
 	}
-	a.Text = e.Message
+	a.Text = mrkdwn.Escape(e.Message)
 	for k, v := range e.Data {
-		a.NewField(k, fmt.Sprint(v))
+		a.NewField(k, mrkdwn.Escape(fmt.Sprint(v)))
+	}
+
+	var key string
+	if v, ok := e.Data[sh.ThreadKey]; ok && sh.ThreadKey != "" {
+		key = fmt.Sprint(v)
 	}
 
 	if sh.Async {
-		go m.Send(sh.client)
+		go sh.send(&m, key)
 		return nil
 	}
 
-	_, err := m.Send(sh.client)
-	return err
+	return sh.send(&m, key)
+}
+
+// send sends m to slack, as a reply in the thread for key if key isn't empty.
+func (sh *Hook) send(m *chat.Message, key string) error {
+	if key == "" {
+		_, err := sh.post(m)
+		return err
+	}
+
+	// Hold the thread's lock while posting so entries which arrive before
+	// the thread's first message has been posted are replies to it.
+	th := sh.threadFor(key)
+	th.mtx.Lock()
+	defer th.mtx.Unlock()
+
+	if th.thread != nil {
+		m.Channel, m.ThreadTS = th.thread.Channel, th.thread.Timestamp
+		_, err := sh.post(m)
+		return err
+	}
+
+	resp, err := sh.post(m)
+	if err != nil {
+		return err
+	}
+	if resp.Timestamp != "" {
+		th.thread = resp.Thread(sh.client)
+	}
+
+	return nil
+}
+
+// post sends m to slack, fitting it within the limits of slack if configured,
+// and returns the response for the first message sent.
+func (sh *Hook) post(m *chat.Message) (*chat.MessageResponse, error) {
+	if sh.Fit != nil {
+		resps, err := m.SendFit(sh.client, *sh.Fit)
+		if err != nil {
+			return nil, err
+		}
+		return resps[0], nil
+	}
+
+	return m.Send(sh.client)
+}
+
+// threadFor returns the thread for key, starting a new thread if there
+// isn't one or it has expired. Expired threads are removed.
+func (sh *Hook) threadFor(key string) *thread {
+	sh.mtx.Lock()
+	defer sh.mtx.Unlock()
+
+	now := time.Now()
+	for k, th := range sh.threads {
+		if now.Sub(th.last) > sh.ThreadTTL {
+			delete(sh.threads, k)
+		}
+	}
+
+	th, ok := sh.threads[key]
+	if !ok {
+		th = &thread{}
+		sh.threads[key] = th
+	}
+	th.last = now
+
+	return th
 }
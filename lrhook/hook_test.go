@@ -2,6 +2,7 @@ package lrhook
 
 import (
 	"bytes"
+	"fmt"
 	"io"
 	"os"
 	"strings"
@@ -93,7 +94,7 @@ func TestHookError(t *testing.T) {
 
 func TestNew(t *testing.T) {
 	cfg := Config{MinLevel: logrus.InfoLevel}
-	h := New(cfg, test.Endpoint)
+	h := New(cfg, test.URL())
 	delete(h.LevelColors, "warning")
 
 	logger := newHookedLogger(h)
@@ -170,6 +171,108 @@ func TestAsync(t *testing.T) {
 	resetBufs()
 }
 
+func TestEscape(t *testing.T) {
+	cfg := Config{MinLevel: logrus.ErrorLevel}
+	h := New(cfg, test.URL())
+
+	logger := newHookedLogger(h)
+	logger.WithField("query", "a < b && b > c").Error("failed <!channel> & more")
+
+	assert.Empty(t, stderr.String())
+
+	r, ok := test.Server().LastRequest()
+	if assert.True(t, ok) {
+		m := &chat.Message{}
+		if assert.NoError(t, r.Decode(m)) && assert.Len(t, m.Attachments, 1) && assert.Len(t, m.Attachments[0].Fields, 1) {
+			assert.Equal(t, "failed &lt;!channel&gt; &amp; more", m.Attachments[0].Text)
+			assert.Equal(t, "a &lt; b &amp;&amp; b &gt; c", m.Attachments[0].Fields[0].Value)
+		}
+	}
+
+	resetBufs()
+}
+
+func TestFit(t *testing.T) {
+	cfg := Config{
+		MinLevel: logrus.ErrorLevel,
+		Fit:      &chat.FitConfig{Ellipsis: "[truncated]"},
+	}
+	h := New(cfg, test.URL())
+
+	logger := newHookedLogger(h)
+	logger.WithField("trace", strings.Repeat("x", chat.MaxFieldValueLen*2)).Error("my error")
+
+	assert.Empty(t, stderr.String())
+
+	r, ok := test.Server().LastRequest()
+	if assert.True(t, ok) {
+		m := &chat.Message{}
+		if assert.NoError(t, r.Decode(m)) && assert.Len(t, m.Attachments, 1) && assert.Len(t, m.Attachments[0].Fields, 1) {
+			v := m.Attachments[0].Fields[0].Value
+			assert.Equal(t, chat.MaxFieldValueLen, len(v))
+			assert.True(t, strings.HasSuffix(v, "[truncated]"))
+		}
+	}
+
+	resetBufs()
+}
+
+func TestThreadKey(t *testing.T) {
+	cfg := Config{
+		MinLevel:  logrus.ErrorLevel,
+		Message:   chat.Message{Channel: "C123"},
+		ThreadKey: "job",
+	}
+	h := NewClient(cfg, test.NewAPI())
+	assert.Equal(t, DefaultThreadTTL, h.ThreadTTL)
+
+	logger := newHookedLogger(h)
+	logger.WithField("job", "backup").Error("backup failed")
+	parent, ok := test.Server().LastRequest()
+	if !assert.True(t, ok) {
+		return
+	}
+	assert.NotContains(t, parent.Values(), "thread_ts")
+
+	logger.WithField("job", "backup").Error("backup retry failed")
+	r, ok := test.Server().LastRequest()
+	if !assert.True(t, ok) {
+		return
+	}
+	threadTS := r.Values()["thread_ts"]
+	assert.NotEmpty(t, threadTS)
+
+	// Entries with a different key or without one aren't part of the thread.
+	logger.WithField("job", "report").Error("report failed")
+	r, ok = test.Server().LastRequest()
+	if assert.True(t, ok) {
+		assert.NotContains(t, r.Values(), "thread_ts")
+	}
+	logger.Error("unrelated")
+	r, ok = test.Server().LastRequest()
+	if assert.True(t, ok) {
+		assert.NotContains(t, r.Values(), "thread_ts")
+	}
+
+	msgs, err := (&chat.Thread{Client: test.NewAPI(), Channel: "C123", Timestamp: threadTS.(string)}).Replies()
+	if assert.NoError(t, err) && assert.Len(t, msgs, 2) {
+		assert.Equal(t, "backup failed", msgs[0].Attachments[0].Text)
+		assert.Equal(t, "backup retry failed", msgs[1].Attachments[0].Text)
+	}
+
+	// Expired threads are replaced by a new one.
+	h.ThreadTTL = time.Nanosecond
+	time.Sleep(time.Millisecond)
+	logger.WithField("job", "backup").Error("backup failed again")
+	r, ok = test.Server().LastRequest()
+	if assert.True(t, ok) {
+		assert.NotContains(t, r.Values(), "thread_ts")
+	}
+
+	assert.Empty(t, stderr.String())
+	resetBufs()
+}
+
 func ExampleNew() {
 	cfg := Config{
 		MinLevel: logrus.ErrorLevel,
@@ -185,3 +288,42 @@ func ExampleNew() {
 	logrus.AddHook(h)
 	logrus.WithFields(logrus.Fields{"field1": "test field", "field2": 1}).Error("test error")
 }
+
+func TestFitSplitThread(t *testing.T) {
+	cfg := Config{
+		MinLevel: logrus.ErrorLevel,
+		Message:  chat.Message{Channel: "C123"},
+		Fit:      &chat.FitConfig{Split: true, Thread: true},
+	}
+	h := NewClient(cfg, test.NewAPI())
+
+	var trace strings.Builder
+	for i := 0; trace.Len() < chat.MaxTextLen+chat.MaxTextLen/2; i++ {
+		fmt.Fprintf(&trace, "goroutine %d [running]:\n\t/src/main.go:%d\n", i, i)
+	}
+	logger := newHookedLogger(h)
+	logger.Error(trace.String())
+
+	assert.Empty(t, stderr.String())
+
+	r, ok := test.Server().LastRequest()
+	if !assert.True(t, ok) {
+		return
+	}
+	threadTS, _ := r.Values()["thread_ts"].(string)
+	if !assert.NotEmpty(t, threadTS) {
+		return
+	}
+
+	// The replies include the first message of the thread.
+	var text string
+	msgs, err := (&chat.Thread{Client: test.NewAPI(), Channel: "C123", Timestamp: threadTS}).Replies()
+	if assert.NoError(t, err) && assert.Len(t, msgs, 2) {
+		for _, m := range msgs {
+			text += m.Attachments[0].Text
+		}
+	}
+	assert.Equal(t, trace.String(), text)
+
+	resetBufs()
+}
@@ -0,0 +1,38 @@
+package slack
+
+import (
+	"context"
+)
+
+// Middleware wraps a client to add behaviour such as logging, metrics or retries.
+//
+// Middleware operates on ContextClient rather than Client so that contexts are
+// passed through the chain.
+type Middleware func(ContextClient) ContextClient
+
+// ClientFunc is an adapter to allow the use of ordinary functions as slack clients.
+type ClientFunc func(ctx context.Context, url string, message, response interface{}) error
+
+// Send implements Client.
+// It calls f(context.Background(), url, message, response).
+func (f ClientFunc) Send(url string, message, response interface{}) error {
+	return f(context.Background(), url, message, response)
+}
+
+// SendContext implements ContextClient.
+// It calls f(ctx, url, message, response).
+func (f ClientFunc) SendContext(ctx context.Context, url string, message, response interface{}) error {
+	return f(ctx, url, message, response)
+}
+
+// Chain returns c wrapped by mw.
+// The first middleware is the outermost so it sees requests first and responses last.
+// If c doesn't implement ContextClient it's adapted using NewContextClient.
+func Chain(c Client, mw ...Middleware) ContextClient {
+	cc := NewContextClient(c)
+	for i := len(mw) - 1; i >= 0; i-- {
+		cc = mw[i](cc)
+	}
+
+	return cc
+}
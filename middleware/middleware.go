@@ -0,0 +1,128 @@
+// Package middleware provides slack.Middleware for debug logging and timing
+// requests made by slack clients.
+//
+// Middleware can be composed with any client using slack.Chain e.g.
+//
+//	c := slack.Chain(webhook.New(url), middleware.Debug(log.Default()))
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/multiplay/go-slack"
+)
+
+// Redacted replaces the values of fields removed by Redact.
+const Redacted = "[REDACTED]"
+
+// RedactKeys are the case insensitive substrings of the names of fields
+// whose values Redact removes, such as token, access_token and client_secret.
+var RedactKeys = []string{"token", "secret", "password", "authorization"}
+
+// RedactFunc returns the JSON encoding b of a request or response with any
+// sensitive values removed so it can be logged.
+type RedactFunc func(b []byte) []byte
+
+// Logger is the interface used to log debug output.
+// It's implemented by *log.Logger.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// Debug returns a slack.Middleware which logs each request and its response
+// or error to l as JSON, with credentials such as tokens removed by Redact.
+func Debug(l Logger) slack.Middleware {
+	return DebugRedact(l, Redact)
+}
+
+// DebugRedact returns a slack.Middleware which logs each request and its
+// response or error to l as JSON, with sensitive values removed by redact.
+func DebugRedact(l Logger, redact RedactFunc) slack.Middleware {
+	return func(next slack.ContextClient) slack.ContextClient {
+		return slack.ClientFunc(func(ctx context.Context, url string, msg, resp interface{}) error {
+			l.Printf("slack: request url: %v, message: %s", url, redact(encode(msg)))
+			if err := next.SendContext(ctx, url, msg, resp); err != nil {
+				l.Printf("slack: response url: %v, error: %v", url, err)
+				return err
+			}
+
+			l.Printf("slack: response url: %v, response: %s", url, redact(encode(resp)))
+			return nil
+		})
+	}
+}
+
+// Redact is a RedactFunc which replaces the values of fields whose names
+// contain any of RedactKeys, at any depth, with Redacted.
+// b is returned unchanged if it isn't valid JSON.
+func Redact(b []byte) []byte {
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return b
+	}
+
+	rb, err := json.Marshal(redact(v))
+	if err != nil {
+		return b
+	}
+
+	return rb
+}
+
+// redact returns v with the values of sensitive fields replaced by Redacted.
+func redact(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, fv := range v {
+			if sensitive(k) {
+				v[k] = Redacted
+			} else {
+				v[k] = redact(fv)
+			}
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = redact(e)
+		}
+	}
+
+	return v
+}
+
+// sensitive returns true if the field named key contains any of RedactKeys.
+func sensitive(key string) bool {
+	key = strings.ToLower(key)
+	for _, k := range RedactKeys {
+		if strings.Contains(key, k) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Timing returns a slack.Middleware which calls f with the url, duration
+// and error of each request once it completes.
+func Timing(f func(url string, d time.Duration, err error)) slack.Middleware {
+	return func(next slack.ContextClient) slack.ContextClient {
+		return slack.ClientFunc(func(ctx context.Context, url string, msg, resp interface{}) error {
+			start := time.Now()
+			err := next.SendContext(ctx, url, msg, resp)
+			f(url, time.Since(start), err)
+			return err
+		})
+	}
+}
+
+// encode returns v encoded as JSON or the encoding error if it fails.
+func encode(v interface{}) []byte {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return []byte(err.Error())
+	}
+
+	return b
+}
@@ -0,0 +1,52 @@
+package slack
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// recorder returns a Middleware which records name before and after calling the next client.
+func recorder(calls *[]string, name string) Middleware {
+	return func(next ContextClient) ContextClient {
+		return ClientFunc(func(ctx context.Context, url string, msg, resp interface{}) error {
+			*calls = append(*calls, name+" before")
+			err := next.SendContext(ctx, url, msg, resp)
+			*calls = append(*calls, name+" after")
+			return err
+		})
+	}
+}
+
+func TestClientFunc(t *testing.T) {
+	type key struct{}
+	var got []interface{}
+	f := ClientFunc(func(ctx context.Context, url string, msg, resp interface{}) error {
+		got = append(got, ctx.Value(key{}), url, msg, resp)
+		return nil
+	})
+
+	assert.NoError(t, f.Send("url", "msg", "resp"))
+	assert.Equal(t, []interface{}{nil, "url", "msg", "resp"}, got)
+
+	got = nil
+	ctx := context.WithValue(context.Background(), key{}, "value")
+	assert.NoError(t, f.SendContext(ctx, "url", "msg", "resp"))
+	assert.Equal(t, []interface{}{"value", "url", "msg", "resp"}, got)
+}
+
+func TestChain(t *testing.T) {
+	var calls []string
+	c := &sendCounter{}
+	cc := Chain(c, recorder(&calls, "first"), recorder(&calls, "second"))
+
+	assert.NoError(t, cc.Send("", nil, nil))
+	assert.Equal(t, 1, c.calls)
+	assert.Equal(t, []string{"first before", "second before", "second after", "first after"}, calls)
+}
+
+func TestChainEmpty(t *testing.T) {
+	c := &ctxSendCounter{}
+	assert.Equal(t, ContextClient(c), Chain(c))
+}
@@ -0,0 +1,200 @@
+// Package mrkdwn provides helpers to escape and format text using slack's
+// mrkdwn markup.
+//
+// Text which may contain &, < or > such as user input must be escaped using
+// Escape before being included in a message, otherwise slack may treat it as
+// a control sequence.
+//
+// Example:
+//
+//	text := mrkdwn.Bold("Deployed") + " by " + mrkdwn.User("U012AB3CD") + ": " + mrkdwn.Escape(msg)
+//
+// See: https://api.slack.com/reference/surfaces/formatting
+package mrkdwn
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Special mentions which notify groups of users.
+const (
+	// Here notifies the active members of a channel.
+	Here = "<!here>"
+
+	// Channel notifies all members of a channel.
+	Channel = "<!channel>"
+
+	// Everyone notifies every member of the workspace, only valid in the #general channel.
+	Everyone = "<!everyone>"
+)
+
+// Date formatting tokens used by Date.
+// See: https://api.slack.com/reference/surfaces/formatting#date-formatting
+const (
+	// DateNum formats the date as 2014-02-18.
+	DateNum = "{date_num}"
+
+	// DateSlash formats the date as 02/18/2014.
+	DateSlash = "{date_slash}"
+
+	// DateLong formats the date as Tuesday, February 18th, 2014.
+	DateLong = "{date_long}"
+
+	// DateLongFull formats the date as Tuesday, February 18th, 2014 including the year if it's the current year.
+	DateLongFull = "{date_long_full}"
+
+	// DateLongPretty formats the date as DateLong but uses yesterday, today or tomorrow where appropriate.
+	DateLongPretty = "{date_long_pretty}"
+
+	// DateFormat formats the date as February 18th, 2014.
+	DateFormat = "{date}"
+
+	// DatePretty formats the date as DateFormat but uses yesterday, today or tomorrow where appropriate.
+	DatePretty = "{date_pretty}"
+
+	// DateShort formats the date as Feb 18, 2014.
+	DateShort = "{date_short}"
+
+	// DateShortPretty formats the date as DateShort but uses yesterday, today or tomorrow where appropriate.
+	DateShortPretty = "{date_short_pretty}"
+
+	// Time formats the time as 6:39 AM or 06:39 depending on the user's settings.
+	Time = "{time}"
+
+	// TimeSecs formats the time as 6:39:45 AM or 06:39:45 depending on the user's settings.
+	TimeSecs = "{time_secs}"
+
+	// Ago formats the time relative to now e.g. 3 minutes ago.
+	Ago = "{ago}"
+)
+
+var escaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// Escape returns s with the control characters &, < and > replaced by their HTML entities.
+func Escape(s string) string {
+	return escaper.Replace(s)
+}
+
+var urlEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "|", "%7C")
+
+// escapeURL returns url escaped as Escape with | also percent-encoded so it
+// can't end the url of a link early.
+func escapeURL(url string) string {
+	return urlEscaper.Replace(url)
+}
+
+// User returns a mention of the user with the given id e.g. U012AB3CD.
+func User(id string) string {
+	return "<@" + id + ">"
+}
+
+// ChannelLink returns a link to the channel with the given id e.g. C123ABC456.
+func ChannelLink(id string) string {
+	return "<#" + id + ">"
+}
+
+// UserGroup returns a mention of the user group with the given id e.g. SAZ94GDB8.
+func UserGroup(id string) string {
+	return "<!subteam^" + id + ">"
+}
+
+// Link returns a link to url displayed as label.
+// If label is empty the url is displayed.
+// Both are escaped so they can't break out of the link.
+func Link(url, label string) string {
+	if label == "" {
+		return "<" + escapeURL(url) + ">"
+	}
+
+	return "<" + escapeURL(url) + "|" + Escape(label) + ">"
+}
+
+// Date returns t formatted in the user's local timezone using format, which
+// may include any of the Date tokens such as DateShort. fallback is displayed
+// by clients which don't support date formatting.
+func Date(t time.Time, format, fallback string) string {
+	return date(t, format, "", fallback)
+}
+
+// DateLink returns t formatted as Date which links to url.
+func DateLink(t time.Time, format, url, fallback string) string {
+	return date(t, format, url, fallback)
+}
+
+func date(t time.Time, format, url, fallback string) string {
+	var b strings.Builder
+	b.WriteString("<!date^")
+	b.WriteString(strconv.FormatInt(t.Unix(), 10))
+	b.WriteString("^")
+	b.WriteString(format)
+	if url != "" {
+		b.WriteString("^")
+		b.WriteString(escapeURL(url))
+	}
+	b.WriteString("|")
+	b.WriteString(Escape(fallback))
+	b.WriteString(">")
+
+	return b.String()
+}
+
+// Bold returns s formatted as bold.
+func Bold(s string) string {
+	return "*" + s + "*"
+}
+
+// Italic returns s formatted as italic.
+func Italic(s string) string {
+	return "_" + s + "_"
+}
+
+// Strike returns s formatted with strikethrough.
+func Strike(s string) string {
+	return "~" + s + "~"
+}
+
+// Code returns s formatted as inline code.
+func Code(s string) string {
+	return "`" + s + "`"
+}
+
+// CodeBlock returns s formatted as a multi-line code block.
+func CodeBlock(s string) string {
+	return "```\n" + s + "\n```"
+}
+
+// Quote returns s formatted as a block quote, quoting every line.
+func Quote(s string) string {
+	return "> " + strings.ReplaceAll(s, "\n", "\n> ")
+}
+
+// List returns items formatted as a bulleted list, one item per line.
+func List(items ...string) string {
+	var b strings.Builder
+	for i, item := range items {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString("• ")
+		b.WriteString(item)
+	}
+
+	return b.String()
+}
+
+// OrderedList returns items formatted as a numbered list, one item per line.
+func OrderedList(items ...string) string {
+	var b strings.Builder
+	for i, item := range items {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString(strconv.Itoa(i + 1))
+		b.WriteString(". ")
+		b.WriteString(item)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,70 @@
+package mrkdwn
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEscape(t *testing.T) {
+	assert.Equal(t, "", Escape(""))
+	assert.Equal(t, "plain text", Escape("plain text"))
+	assert.Equal(t, "a &lt; b &amp;&amp; b &gt; c", Escape("a < b && b > c"))
+	assert.Equal(t, "&amp;amp;", Escape("&amp;"))
+	assert.Equal(t, "&lt;!channel&gt;", Escape(Channel))
+}
+
+func TestMentions(t *testing.T) {
+	assert.Equal(t, "<@U012AB3CD>", User("U012AB3CD"))
+	assert.Equal(t, "<#C123ABC456>", ChannelLink("C123ABC456"))
+	assert.Equal(t, "<!subteam^SAZ94GDB8>", UserGroup("SAZ94GDB8"))
+	assert.Equal(t, "<!here>", Here)
+	assert.Equal(t, "<!channel>", Channel)
+	assert.Equal(t, "<!everyone>", Everyone)
+}
+
+func TestLink(t *testing.T) {
+	assert.Equal(t, "<https://example.com>", Link("https://example.com", ""))
+	assert.Equal(t, "<https://example.com|Example>", Link("https://example.com", "Example"))
+	assert.Equal(t, "<https://example.com|a &lt;b&gt;>", Link("https://example.com", "a <b>"))
+	assert.Equal(t, "<https://example.com/?a=1&amp;b=%7C&gt;&lt;!channel&gt;|x>", Link("https://example.com/?a=1&b=|><!channel>", "x"))
+	assert.Equal(t, "<https://example.com/%7Cx&gt;>", Link("https://example.com/|x>", ""))
+}
+
+func TestDate(t *testing.T) {
+	ts := time.Unix(1392734382, 0)
+	assert.Equal(t, "<!date^1392734382^{date_short} at {time}|Feb 18, 2014>", Date(ts, DateShort+" at "+Time, "Feb 18, 2014"))
+	assert.Equal(t, "<!date^1392734382^{ago}|a &amp; b>", Date(ts, Ago, "a & b"))
+	assert.Equal(t, "<!date^1392734382^{date_num}^https://example.com|2014-02-18>", DateLink(ts, DateNum, "https://example.com", "2014-02-18"))
+	assert.Equal(t, "<!date^1392734382^{date_num}^https://example.com/%7C&gt;|2014-02-18>", DateLink(ts, DateNum, "https://example.com/|>", "2014-02-18"))
+}
+
+func TestFormatting(t *testing.T) {
+	assert.Equal(t, "*bold*", Bold("bold"))
+	assert.Equal(t, "_italic_", Italic("italic"))
+	assert.Equal(t, "~strike~", Strike("strike"))
+	assert.Equal(t, "`code`", Code("code"))
+	assert.Equal(t, "```\nline1\nline2\n```", CodeBlock("line1\nline2"))
+	assert.Equal(t, "> quote", Quote("quote"))
+	assert.Equal(t, "> line1\n> line2", Quote("line1\nline2"))
+}
+
+func TestList(t *testing.T) {
+	assert.Equal(t, "", List())
+	assert.Equal(t, "• one", List("one"))
+	assert.Equal(t, "• one\n• two", List("one", "two"))
+	assert.Equal(t, "", OrderedList())
+	assert.Equal(t, "1. one\n2. two", OrderedList("one", "two"))
+}
+
+func Example() {
+	msg := "disk usage > 90% on db1 & db2"
+	fmt.Println(Bold("Alert") + " for " + UserGroup("SAZ94GDB8") + ": " + Escape(msg))
+	fmt.Println(List(Code("db1"), Code("db2")))
+	// Output:
+	// *Alert* for <!subteam^SAZ94GDB8>: disk usage &gt; 90% on db1 &amp; db2
+	// • `db1`
+	// • `db2`
+}
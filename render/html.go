@@ -0,0 +1,151 @@
+package render
+
+import (
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/multiplay/go-slack/chat"
+)
+
+// HTML returns m rendered as a self-contained HTML document which
+// approximates slack's presentation of the message.
+func HTML(m *chat.Message) string {
+	return (&renderer{style: htmlStyle{}}).message(m)
+}
+
+// htmlHead is the start of the HTML document up to the message.
+const htmlHead = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Slack message</title>
+<style>
+body { font-family: Lato, "Helvetica Neue", Helvetica, Arial, sans-serif; font-size: 15px; line-height: 1.47; color: #1d1c1d; margin: 20px; }
+.message { max-width: 700px; }
+.part { margin-bottom: 8px; }
+.attachment { border-left: 4px solid #dddddd; padding: 2px 0 2px 12px; }
+.columns { display: grid; grid-template-columns: 1fr 1fr; column-gap: 16px; }
+.dim { color: #616061; font-size: 12px; }
+.mention { color: #1264a3; background: #e8f5fa; border-radius: 3px; padding: 0 2px; }
+a { color: #1264a3; text-decoration: none; }
+code { color: #e01e5a; background: #f6f6f6; border: 1px solid #dddddd; border-radius: 3px; padding: 0 3px; font-size: 12px; }
+pre { background: #f8f8f8; border: 1px solid #dddddd; border-radius: 4px; padding: 8px; margin: 4px 0; font-size: 12px; white-space: pre-wrap; }
+blockquote { border-left: 4px solid #dddddd; margin: 0; padding-left: 12px; }
+hr { border: 0; border-top: 1px solid #dddddd; }
+div + br, pre + br, blockquote + br, hr + br { display: none; }
+</style>
+</head>
+<body>
+<div class="message">
+`
+
+// htmlTail is the end of the HTML document after the message.
+const htmlTail = `</div>
+</body>
+</html>
+`
+
+// htmlStyle renders HTML.
+type htmlStyle struct{}
+
+func (htmlStyle) text(s string) string {
+	return html.EscapeString(s)
+}
+
+func (htmlStyle) bold(s string) string {
+	return "<b>" + s + "</b>"
+}
+
+func (htmlStyle) italic(s string) string {
+	return "<i>" + s + "</i>"
+}
+
+func (htmlStyle) strike(s string) string {
+	return "<s>" + s + "</s>"
+}
+
+func (htmlStyle) quote(s string) string {
+	return "<blockquote>" + s + "</blockquote>"
+}
+
+func (htmlStyle) code(s string) string {
+	return "<code>" + html.EscapeString(s) + "</code>"
+}
+
+func (htmlStyle) pre(s string) string {
+	return "<pre>" + html.EscapeString(s) + "</pre>"
+}
+
+func (htmlStyle) link(url, label string) string {
+	if !safeURL(url) {
+		return html.EscapeString(label)
+	}
+
+	return `<a href="` + html.EscapeString(url) + `">` + html.EscapeString(label) + "</a>"
+}
+
+// safeURL returns true if url uses a scheme which is safe to link to.
+func safeURL(url string) bool {
+	url = strings.ToLower(url)
+	for _, p := range []string{"https://", "http://", "mailto:"} {
+		if strings.HasPrefix(url, p) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (htmlStyle) mention(s string) string {
+	return `<span class="mention">` + html.EscapeString(s) + "</span>"
+}
+
+func (htmlStyle) dim(s string) string {
+	return `<span class="dim">` + s + "</span>"
+}
+
+func (htmlStyle) br() string {
+	return "<br>\n"
+}
+
+func (htmlStyle) rule() string {
+	return "<hr>"
+}
+
+func (htmlStyle) columns(left, right string) string {
+	return `<div class="columns"><div>` + left + "</div><div>" + right + "</div></div>"
+}
+
+func (htmlStyle) attachment(color, pretext, body string) string {
+	if c, ok := namedColors[color]; ok {
+		color = c
+	}
+
+	var b strings.Builder
+	if pretext != "" {
+		b.WriteString("<div>" + pretext + "</div>\n")
+	}
+	if r, g, bl, ok := rgb(color); ok {
+		fmt.Fprintf(&b, `<div class="attachment" style="border-left-color: #%02x%02x%02x">`, r, g, bl)
+	} else {
+		b.WriteString(`<div class="attachment">`)
+	}
+	b.WriteString(body)
+	b.WriteString("</div>")
+
+	return b.String()
+}
+
+func (htmlStyle) document(parts []string) string {
+	var b strings.Builder
+	b.WriteString(htmlHead)
+	for _, p := range parts {
+		b.WriteString(`<div class="part">`)
+		b.WriteString(p)
+		b.WriteString("</div>\n")
+	}
+	b.WriteString(htmlTail)
+
+	return b.String()
+}
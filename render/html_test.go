@@ -0,0 +1,50 @@
+package render
+
+import (
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiplay/go-slack/chat"
+	"github.com/stretchr/testify/assert"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+// golden compares actual to the golden file testdata/name, first updating it if -update is set.
+func golden(t *testing.T, name, actual string) {
+	path := filepath.Join("testdata", name)
+	if *update {
+		if err := ioutil.WriteFile(path, []byte(actual), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expected, err := ioutil.ReadFile(path)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, string(expected), actual)
+}
+
+func TestHTML(t *testing.T) {
+	golden(t, "message.html", HTML(testMessage()))
+}
+
+func TestHTMLBlocks(t *testing.T) {
+	golden(t, "blocks.html", HTML(testBlocksMessage()))
+}
+
+func TestHTMLValueBlocks(t *testing.T) {
+	golden(t, "value_blocks.html", HTML(testValueBlocksMessage()))
+}
+
+func TestHTMLEscaping(t *testing.T) {
+	m := &chat.Message{Text: "<script>alert(1)</script> &lt;b&gt; <javascript:alert(1)|x\"y>"}
+	a := m.NewAttachment()
+	a.Color = `red" onload="alert(1)`
+	a.NewField("<i>", "`<b>`")
+
+	golden(t, "escaping.html", HTML(m))
+}
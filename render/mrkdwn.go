@@ -0,0 +1,178 @@
+package render
+
+import (
+	"strings"
+)
+
+var unescaper = strings.NewReplacer("&amp;", "&", "&lt;", "<", "&gt;", ">")
+
+// unescape returns s with the HTML entities used by mrkdwn replaced by the characters they represent.
+func unescape(s string) string {
+	return unescaper.Replace(s)
+}
+
+// mrkdwn renders the mrkdwn s using the renderer's style, resolving links,
+// mentions and dates. If format is false text styling such as *bold* isn't
+// applied, matching slack's behaviour for text which doesn't enable mrkdwn.
+func (r *renderer) mrkdwn(s string, format bool) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+
+	var b strings.Builder
+	for s != "" {
+		i := strings.Index(s, "```")
+		if !format || i < 0 {
+			b.WriteString(r.lines(s, format))
+			break
+		}
+
+		j := strings.Index(s[i+3:], "```")
+		if j < 0 {
+			b.WriteString(r.lines(s, format))
+			break
+		}
+
+		if i > 0 {
+			b.WriteString(r.lines(strings.TrimSuffix(strings.TrimRight(s[:i], " "), "\n"), format))
+			b.WriteString(r.style.br())
+		}
+		pre := strings.TrimPrefix(s[i+3:i+3+j], "\n")
+		b.WriteString(r.style.pre(unescape(strings.TrimSuffix(pre, "\n"))))
+		s = s[i+3+j+3:]
+		if s != "" {
+			s = strings.TrimPrefix(strings.TrimLeft(s, " "), "\n")
+			b.WriteString(r.style.br())
+		}
+	}
+
+	return b.String()
+}
+
+// lines renders the lines of the mrkdwn s, handling block quotes.
+func (r *renderer) lines(s string, format bool) string {
+	lines := strings.Split(s, "\n")
+	for i, l := range lines {
+		switch {
+		case format && strings.HasPrefix(l, "&gt; "):
+			lines[i] = r.style.quote(r.inline(l[5:], format))
+		case format && strings.HasPrefix(l, "> "):
+			lines[i] = r.style.quote(r.inline(l[2:], format))
+		default:
+			lines[i] = r.inline(l, format)
+		}
+	}
+
+	return strings.Join(lines, r.style.br())
+}
+
+// inline renders the single line of mrkdwn s.
+func (r *renderer) inline(s string, format bool) string {
+	var b strings.Builder
+	start := 0
+	flush := func(i int) {
+		if i > start {
+			b.WriteString(r.style.text(unescape(s[start:i])))
+		}
+	}
+
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == '<':
+			j := strings.IndexByte(s[i:], '>')
+			if j < 0 {
+				break
+			}
+			flush(i)
+			b.WriteString(r.special(s[i+1 : i+j]))
+			i += j + 1
+			start = i
+			continue
+		case !format:
+		case c == '`':
+			j := strings.IndexByte(s[i+1:], '`')
+			if j <= 0 {
+				break
+			}
+			flush(i)
+			b.WriteString(r.style.code(unescape(s[i+1 : i+1+j])))
+			i += j + 2
+			start = i
+			continue
+		case c == '*' || c == '_' || c == '~':
+			j := closing(s, i)
+			if j < 0 {
+				break
+			}
+			flush(i)
+			inner := r.inline(s[i+1:j], format)
+			switch c {
+			case '*':
+				b.WriteString(r.style.bold(inner))
+			case '_':
+				b.WriteString(r.style.italic(inner))
+			default:
+				b.WriteString(r.style.strike(inner))
+			}
+			i = j + 1
+			start = i
+			continue
+		}
+		i++
+	}
+	flush(len(s))
+
+	return b.String()
+}
+
+// closing returns the index of the delimiter which closes the formatting
+// opened by the delimiter at s[i] or -1 if it isn't formatting.
+func closing(s string, i int) int {
+	c := s[i]
+	if i > 0 && isWord(s[i-1]) || i+1 >= len(s) || s[i+1] == ' ' || s[i+1] == c {
+		return -1
+	}
+
+	for j := i + 2; j < len(s); j++ {
+		if s[j] == c && s[j-1] != ' ' && (j+1 == len(s) || !isWord(s[j+1])) {
+			return j
+		}
+	}
+
+	return -1
+}
+
+func isWord(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= 0x80
+}
+
+// special renders the contents of a <...> control sequence such as a link or mention.
+func (r *renderer) special(s string) string {
+	target, label := s, ""
+	if i := strings.IndexByte(s, '|'); i >= 0 {
+		target, label = s[:i], unescape(s[i+1:])
+	}
+
+	switch {
+	case strings.HasPrefix(target, "@"):
+		return r.style.mention("@" + or(label, target[1:]))
+	case strings.HasPrefix(target, "#"):
+		return r.style.mention("#" + or(label, target[1:]))
+	case strings.HasPrefix(target, "!subteam^"):
+		return r.style.mention(or(label, "@"+target[len("!subteam^"):]))
+	case strings.HasPrefix(target, "!date^"):
+		return r.style.text(label)
+	case strings.HasPrefix(target, "!"):
+		return r.style.mention(or(label, "@"+target[1:]))
+	}
+
+	return r.style.link(unescape(target), or(label, unescape(target)))
+}
+
+// or returns s or if it's empty def.
+func or(s, def string) string {
+	if s == "" {
+		return def
+	}
+
+	return s
+}
@@ -0,0 +1,52 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMrkdwnText(t *testing.T) {
+	tests := []struct {
+		name     string
+		mrkdwn   string
+		expected string
+	}{
+		{"plain", "plain text", "plain text"},
+		{"entities", "a &lt; b &amp;&amp; c &gt; d", "a < b && c > d"},
+		{"formatting", "*bold* _italic_ ~strike~ `code`", "bold italic strike code"},
+		{"nested", "*bold _italic_*", "bold italic"},
+		{"not-formatting", "2*3*4 snake_case_name * spaced *", "2*3*4 snake_case_name * spaced *"},
+		{"link", "<https://go.dev|Go &amp; more>", "Go & more (https://go.dev)"},
+		{"bare-link", "<https://go.dev>", "https://go.dev"},
+		{"user", "<@U123> <@U123|bob>", "@U123 @bob"},
+		{"channel", "<#C123> <#C123|general>", "#C123 #general"},
+		{"special", "<!here> <!channel>", "@here @channel"},
+		{"usergroup", "<!subteam^S123> <!subteam^S123|@ops>", "@S123 @ops"},
+		{"date", "<!date^1392734382^{date_short}|Feb 18, 2014>", "Feb 18, 2014"},
+		{"quote", "&gt; quoted\n> *also*\nnot", "> quoted\n> also\nnot"},
+		{"pre", "before\n```\nif a &lt; b {\n}\n```\nafter", "before\n    if a < b {\n    }\nafter"},
+		{"pre-inline", "run ```make``` now", "run\n    make\nnow"},
+		{"unclosed", "*open `tick <no close", "*open `tick <no close"},
+	}
+
+	r := &renderer{style: textStyle{}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, r.mrkdwn(tc.mrkdwn, true))
+		})
+	}
+}
+
+func TestMrkdwnNoFormat(t *testing.T) {
+	r := &renderer{style: ansiStyle{}}
+	assert.Equal(t, "*not bold* ```not pre```", r.mrkdwn("*not bold* ```not pre```", false))
+	assert.Equal(t, ansiBold+ansiBlue+"@bob"+ansiNoColor+ansiNoBold, r.mrkdwn("<@U123|bob>", false))
+}
+
+func TestMrkdwnANSI(t *testing.T) {
+	r := &renderer{style: ansiStyle{}}
+	assert.Equal(t, ansiBold+"bold "+ansiItalic+"it"+ansiNoItalic+ansiNoBold, r.mrkdwn("*bold _it_*", true))
+	assert.Equal(t, ansiCyan+"a<b"+ansiNoColor, r.mrkdwn("`a&lt;b`", true))
+	assert.Equal(t, ansiUnderline+ansiBlue+"Go"+ansiNoColor+ansiNoUnder+" "+ansiGrey+"(https://go.dev)"+ansiNoColor, r.mrkdwn("<https://go.dev|Go>", true))
+}
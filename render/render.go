@@ -0,0 +1,398 @@
+// Package render renders chat messages as text or HTML so they can be
+// previewed or archived without posting them to slack.
+//
+// The rendering approximates slack's presentation of the message, including
+// its attachments, fields and blocks, with mrkdwn formatting applied and links,
+// mentions and dates resolved.
+package render
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/multiplay/go-slack/chat"
+)
+
+// TimeFormat is the format used to render attachment timestamps.
+var TimeFormat = "Jan 2, 2006 15:04 MST"
+
+// Text returns m rendered as plain text.
+func Text(m *chat.Message) string {
+	return (&renderer{style: textStyle{}}).message(m)
+}
+
+// ANSI returns m rendered as text for display in a terminal, using ANSI
+// escape sequences for formatting and the color bars of attachments.
+func ANSI(m *chat.Message) string {
+	return (&renderer{style: ansiStyle{}}).message(m)
+}
+
+// renderer renders messages as text using style.
+type renderer struct {
+	style style
+}
+
+// message renders m. If m has blocks its text isn't rendered as, like slack,
+// it's only used for notifications.
+func (r *renderer) message(m *chat.Message) string {
+	var parts []string
+	if name := or(m.Username, m.IconEmoji); name != "" {
+		parts = append(parts, r.style.bold(r.style.text(name)))
+	}
+
+	if len(m.Blocks) > 0 {
+		parts = append(parts, r.blocks(m.Blocks)...)
+	} else if m.Text != "" {
+		parts = append(parts, r.mrkdwn(m.Text, true))
+	}
+
+	for _, a := range m.Attachments {
+		if a != nil {
+			parts = append(parts, r.attachment(a))
+		}
+	}
+
+	return r.style.document(parts)
+}
+
+// markdownIn returns true if mrkdwn formatting is enabled for the attachment field name.
+func markdownIn(a *chat.Attachment, name string) bool {
+	if len(a.MarkdownIn) == 0 {
+		return true
+	}
+
+	for _, n := range a.MarkdownIn {
+		if n == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// attachment renders a with its color bar.
+func (r *renderer) attachment(a *chat.Attachment) string {
+	var lines []string
+	add := func(s string) {
+		if s != "" {
+			lines = append(lines, s)
+		}
+	}
+
+	if a.AuthorName != "" {
+		author := r.style.text(a.AuthorName)
+		if a.AuthorLink != "" {
+			author = r.style.link(a.AuthorLink, a.AuthorName)
+		}
+		add(r.style.dim(author))
+	}
+	if a.Title != "" {
+		title := r.style.text(a.Title)
+		if a.TitleLink != "" {
+			title = r.style.link(a.TitleLink, a.Title)
+		}
+		add(r.style.bold(title))
+	}
+	if a.Text != "" {
+		add(r.mrkdwn(a.Text, markdownIn(a, "text")))
+	}
+	add(r.fields(a.Fields, markdownIn(a, "fields")))
+	if len(a.Blocks) > 0 {
+		add(strings.Join(r.blocks(a.Blocks), r.style.br()))
+	}
+	if a.ImageURL != "" {
+		add(r.style.link(a.ImageURL, "[image]"))
+	}
+	if a.ThumbURL != "" {
+		add(r.style.link(a.ThumbURL, "[thumbnail]"))
+	}
+
+	var footer []string
+	if a.Footer != "" {
+		footer = append(footer, r.mrkdwn(a.Footer, false))
+	}
+	if a.TimeStamp != 0 {
+		footer = append(footer, r.style.text(time.Unix(int64(a.TimeStamp), 0).UTC().Format(TimeFormat)))
+	}
+	if len(footer) > 0 {
+		add(r.style.dim(strings.Join(footer, " | ")))
+	}
+
+	var pretext string
+	if a.PreText != "" {
+		pretext = r.mrkdwn(a.PreText, markdownIn(a, "pretext"))
+	}
+
+	return r.style.attachment(a.Color, pretext, strings.Join(lines, r.style.br()))
+}
+
+// fields renders fields as a table, with consecutive short fields side by side.
+func (r *renderer) fields(fields []*chat.Field, format bool) string {
+	var cells []string
+	var short []bool
+	for _, f := range fields {
+		if f == nil {
+			continue
+		}
+		cell := r.style.bold(r.style.text(f.Title))
+		if f.Value != "" {
+			cell += r.style.br() + r.mrkdwn(f.Value, format)
+		}
+		cells = append(cells, cell)
+		short = append(short, f.Short)
+	}
+
+	var rows []string
+	for i := 0; i < len(cells); i++ {
+		if short[i] && i+1 < len(cells) && short[i+1] {
+			rows = append(rows, r.style.columns(cells[i], cells[i+1]))
+			i++
+			continue
+		}
+		rows = append(rows, cells[i])
+	}
+
+	return strings.Join(rows, r.style.br())
+}
+
+// blocks renders blocks, returning the rendering of each.
+func (r *renderer) blocks(blocks chat.Blocks) []string {
+	var parts []string
+	for _, b := range blocks {
+		if s := r.block(b); s != "" {
+			parts = append(parts, s)
+		}
+	}
+
+	return parts
+}
+
+// textObject renders the text object t.
+func (r *renderer) textObject(t *chat.TextObject) string {
+	if t == nil {
+		return ""
+	}
+	if t.Type == chat.MarkdownType {
+		return r.mrkdwn(t.Text, true)
+	}
+
+	return r.style.text(t.Text)
+}
+
+func (r *renderer) block(b chat.Block) string {
+	switch b := pointer(b).(type) {
+	case *chat.SectionBlock:
+		var lines []string
+		if b.Text != nil {
+			lines = append(lines, r.textObject(b.Text))
+		}
+		for i := 0; i < len(b.Fields); i += 2 {
+			if i+1 < len(b.Fields) {
+				lines = append(lines, r.style.columns(r.textObject(b.Fields[i]), r.textObject(b.Fields[i+1])))
+			} else {
+				lines = append(lines, r.textObject(b.Fields[i]))
+			}
+		}
+		if s := r.element(b.Accessory); s != "" {
+			lines = append(lines, s)
+		}
+		return strings.Join(lines, r.style.br())
+	case *chat.DividerBlock:
+		return r.style.rule()
+	case *chat.HeaderBlock:
+		return r.style.bold(r.textObject(b.Text))
+	case *chat.ContextBlock:
+		var s []string
+		for _, e := range b.Elements {
+			switch e := pointer(e).(type) {
+			case *chat.TextObject:
+				s = append(s, r.textObject(e))
+			case *chat.ImageElement:
+				s = append(s, r.element(e))
+			}
+		}
+		return r.style.dim(strings.Join(s, "  "))
+	case *chat.ImageBlock:
+		s := r.style.link(b.ImageURL, "[image: "+b.AltText+"]")
+		if b.Title != nil {
+			s = r.textObject(b.Title) + r.style.br() + s
+		}
+		return s
+	case *chat.ActionsBlock:
+		var s []string
+		for _, e := range b.Elements {
+			if e := r.element(e); e != "" {
+				s = append(s, e)
+			}
+		}
+		return strings.Join(s, " ")
+	case *chat.InputBlock:
+		s := r.style.bold(r.textObject(b.Label))
+		if e := r.element(b.Element); e != "" {
+			s += r.style.br() + e
+		}
+		if b.Hint != nil {
+			s += r.style.br() + r.style.dim(r.textObject(b.Hint))
+		}
+		return s
+	case *chat.FileBlock:
+		return r.style.dim(r.style.text("[file: " + b.ExternalID + "]"))
+	case *chat.RichTextBlock:
+		var s []string
+		for _, e := range b.Elements {
+			if e := r.richText(e); e != "" {
+				s = append(s, e)
+			}
+		}
+		return strings.Join(s, r.style.br())
+	case *chat.Unknown:
+		return r.style.dim(r.style.text("[" + b.Type + " block]"))
+	}
+
+	return ""
+}
+
+// element renders the block element e.
+func (r *renderer) element(e chat.Element) string {
+	var label string
+	switch e := pointer(e).(type) {
+	case *chat.ButtonElement:
+		if e.URL != "" && e.Text != nil {
+			return "[ " + r.style.link(e.URL, e.Text.Text) + " ]"
+		}
+		return "[ " + r.style.bold(r.textObject(e.Text)) + " ]"
+	case *chat.ImageElement:
+		return r.style.link(e.ImageURL, "[image: "+e.AltText+"]")
+	case *chat.StaticSelectElement:
+		label = r.textObject(e.Placeholder)
+	case *chat.ExternalSelectElement:
+		label = r.textObject(e.Placeholder)
+	case *chat.UsersSelectElement:
+		label = r.textObject(e.Placeholder)
+	case *chat.ConversationsSelectElement:
+		label = r.textObject(e.Placeholder)
+	case *chat.ChannelsSelectElement:
+		label = r.textObject(e.Placeholder)
+	case *chat.OverflowElement:
+		label = r.style.text("…")
+	case *chat.Unknown:
+		label = r.style.text(e.Type)
+	case nil:
+		return ""
+	default:
+		label = r.style.text(e.ElementType())
+	}
+
+	return "[ " + label + " ▾ ]"
+}
+
+// richText renders a rich text element.
+func (r *renderer) richText(e chat.RichTextElement) string {
+	switch e := pointer(e).(type) {
+	case *chat.RichTextSection:
+		return r.richTextInlines(e.Elements)
+	case *chat.RichTextList:
+		items := make([]string, len(e.Elements))
+		for i, item := range e.Elements {
+			marker := "• "
+			if e.Style == chat.ListStyleOrdered {
+				marker = strconv.Itoa(e.Offset+i+1) + ". "
+			}
+			items[i] = r.style.text(strings.Repeat("    ", e.Indent) + marker)
+			if item != nil {
+				items[i] += r.richTextInlines(item.Elements)
+			}
+		}
+		return strings.Join(items, r.style.br())
+	case *chat.RichTextPreformatted:
+		var b strings.Builder
+		for _, in := range e.Elements {
+			switch in := pointer(in).(type) {
+			case *chat.RichTextText:
+				b.WriteString(in.Text)
+			case *chat.RichTextLink:
+				b.WriteString(or(in.Text, in.URL))
+			}
+		}
+		return r.style.pre(b.String())
+	case *chat.RichTextQuote:
+		return r.style.quote(r.richTextInlines(e.Elements))
+	}
+
+	return ""
+}
+
+// richTextInlines renders rich text inline elements.
+func (r *renderer) richTextInlines(elems chat.RichTextInlines) string {
+	var b strings.Builder
+	for _, e := range elems {
+		var s string
+		var st *chat.RichTextStyle
+		switch e := pointer(e).(type) {
+		case *chat.RichTextText:
+			s, st = r.style.text(e.Text), e.Style
+			if st != nil && st.Code {
+				s = r.style.code(e.Text)
+			}
+		case *chat.RichTextLink:
+			s, st = r.style.link(e.URL, or(e.Text, e.URL)), e.Style
+		case *chat.RichTextEmoji:
+			s = r.style.text(":" + e.Name + ":")
+		case *chat.RichTextUser:
+			s = r.style.mention("@" + e.UserID)
+		case *chat.RichTextChannel:
+			s = r.style.mention("#" + e.ChannelID)
+		case *chat.RichTextUserGroup:
+			s = r.style.mention("@" + e.UserGroupID)
+		case *chat.RichTextBroadcast:
+			s = r.style.mention("@" + e.Range)
+		case *chat.RichTextDate:
+			s = r.style.text(or(e.Fallback, time.Unix(e.Timestamp, 0).UTC().Format(TimeFormat)))
+		case *chat.RichTextColor:
+			s = r.style.text(e.Value)
+		}
+
+		if st != nil {
+			if st.Bold {
+				s = r.style.bold(s)
+			}
+			if st.Italic {
+				s = r.style.italic(s)
+			}
+			if st.Strike {
+				s = r.style.strike(s)
+			}
+		}
+		b.WriteString(s)
+	}
+
+	return b.String()
+}
+
+// pointer returns a pointer to a copy of v if v is a struct value so blocks
+// and elements created as values are rendered the same as pointers, or nil
+// if v is a nil pointer so it's skipped the same as a nil interface.
+func pointer[T any](v T) T {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Ptr:
+		if rv.IsNil() {
+			var zero T
+			return zero
+		}
+		return v
+	case reflect.Struct:
+	default:
+		return v
+	}
+
+	p := reflect.New(rv.Type())
+	p.Elem().Set(rv)
+	if pv, ok := p.Interface().(T); ok {
+		return pv
+	}
+
+	return v
+}
@@ -0,0 +1,200 @@
+package render
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/multiplay/go-slack/chat"
+	"github.com/stretchr/testify/assert"
+)
+
+func testMessage() *chat.Message {
+	m := &chat.Message{Username: "deploybot", Text: "Deploy of *api* finished <!here>"}
+	a := m.NewAttachment()
+	a.Color = "good"
+	a.PreText = "Summary"
+	a.AuthorName = "CI"
+	a.Title = "Build 42"
+	a.TitleLink = "https://ci.example.com/42"
+	a.Text = "All `12` checks passed"
+	a.NewField("Env", "prod")
+	a.NewField("Region", "eu-west-1")
+	a.AddField(&chat.Field{Title: "Changes", Value: "• fix &lt;bug&gt;\n• add feature"})
+	a.Footer = "ci"
+	a.TimeStamp = 1392734382
+
+	return m
+}
+
+func TestText(t *testing.T) {
+	expected := `deploybot
+
+Deploy of api finished @here
+
+Summary
+| CI
+| Build 42 (https://ci.example.com/42)
+| All 12 checks passed
+| Env     Region
+| prod    eu-west-1
+| Changes
+| • fix <bug>
+| • add feature
+| ci | Feb 18, 2014 14:39 UTC
+`
+	assert.Equal(t, expected, Text(testMessage()))
+}
+
+func TestTextMarkdownIn(t *testing.T) {
+	m := &chat.Message{}
+	a := m.NewAttachment()
+	a.Text = "*not bold* <@U1|bob>"
+	a.MarkdownIn = []string{"fields"}
+	a.NewField("*Key*", "*bold*")
+
+	assert.Equal(t, "| *not bold* @bob\n| *Key*\n| bold\n", Text(m))
+}
+
+func testBlocksMessage() *chat.Message {
+	m := &chat.Message{Text: "fallback only used for notifications"}
+	m.AddBlock(&chat.HeaderBlock{Text: chat.NewPlainText("Release")})
+	m.AddBlock(&chat.SectionBlock{
+		Text:      chat.NewMarkdownText("*Highlights*"),
+		Fields:    []*chat.TextObject{chat.NewMarkdownText("*A*\n1"), chat.NewPlainText("B"), chat.NewPlainText("C")},
+		Accessory: chat.NewButton("details", "Details", "d"),
+	})
+	m.AddBlock(&chat.DividerBlock{})
+	m.AddBlock(&chat.ContextBlock{Elements: chat.ContextElements{
+		chat.NewPlainText("by ci"),
+		&chat.ImageElement{ImageURL: "https://example.com/ci.png", AltText: "ci"},
+	}})
+	m.AddBlock(&chat.ActionsBlock{Elements: chat.Elements{
+		&chat.ButtonElement{Text: chat.NewPlainText("Open"), URL: "https://example.com"},
+		&chat.StaticSelectElement{Placeholder: chat.NewPlainText("Pick")},
+		&chat.DatePickerElement{},
+	}})
+	m.AddBlock(&chat.InputBlock{Label: chat.NewPlainText("Reason"), Element: &chat.PlainTextInputElement{}, Hint: chat.NewPlainText("Why?")})
+	m.AddBlock(&chat.ImageBlock{ImageURL: "https://example.com/graph.png", AltText: "graph", Title: chat.NewPlainText("Latency")})
+	m.AddBlock(&chat.FileBlock{ExternalID: "ABC", Source: "remote"})
+	m.AddBlock(&chat.RichTextBlock{Elements: chat.RichTextElements{
+		&chat.RichTextSection{Elements: chat.RichTextInlines{
+			&chat.RichTextText{Text: "Hi "},
+			&chat.RichTextUser{UserID: "U1"},
+			&chat.RichTextText{Text: " see ", Style: &chat.RichTextStyle{Bold: true}},
+			&chat.RichTextLink{URL: "https://go.dev", Text: "docs"},
+			&chat.RichTextEmoji{Name: "tada"},
+		}},
+		&chat.RichTextList{Style: chat.ListStyleOrdered, Elements: []*chat.RichTextSection{
+			{Elements: chat.RichTextInlines{&chat.RichTextText{Text: "one"}}},
+			{Elements: chat.RichTextInlines{&chat.RichTextText{Text: "two", Style: &chat.RichTextStyle{Code: true}}}},
+		}},
+		&chat.RichTextPreformatted{Elements: chat.RichTextInlines{&chat.RichTextText{Text: "make\nmake test"}}},
+		&chat.RichTextQuote{Elements: chat.RichTextInlines{&chat.RichTextDate{Timestamp: 1392734382, Format: "{date}"}}},
+	}})
+	m.AddBlock(&chat.Unknown{Type: "video"})
+
+	return m
+}
+
+// testValueBlocksMessage returns a message with blocks and elements created
+// as values and nil pointers, which must render the same as pointers.
+func testValueBlocksMessage() *chat.Message {
+	m := &chat.Message{}
+	m.AddBlock(chat.HeaderBlock{Text: chat.NewPlainText("Release")})
+	m.AddBlock((*chat.SectionBlock)(nil))
+	m.AddBlock(chat.SectionBlock{Text: chat.NewPlainText("Done"), Accessory: (*chat.ButtonElement)(nil)})
+	m.AddBlock(chat.DividerBlock{})
+	m.AddBlock(chat.ContextBlock{Elements: chat.ContextElements{
+		chat.TextObject{Type: chat.PlainTextType, Text: "by ci"},
+		(*chat.ImageElement)(nil),
+	}})
+	m.AddBlock(chat.ActionsBlock{Elements: chat.Elements{
+		chat.ButtonElement{Text: chat.NewPlainText("Open"), URL: "https://example.com"},
+		(*chat.ButtonElement)(nil),
+	}})
+	m.AddBlock(chat.RichTextBlock{Elements: chat.RichTextElements{
+		chat.RichTextSection{Elements: chat.RichTextInlines{chat.RichTextText{Text: "Hi"}, (*chat.RichTextUser)(nil)}},
+		(*chat.RichTextQuote)(nil),
+		chat.RichTextList{Elements: []*chat.RichTextSection{nil}},
+	}})
+
+	return m
+}
+
+func TestTextValueBlocks(t *testing.T) {
+	expected := "Release\n\nDone\n\n" + strings.Repeat("─", 40) + "\n\nby ci\n\n[ Open (https://example.com) ]\n\nHi\n• \n"
+	assert.Equal(t, expected, Text(testValueBlocksMessage()))
+}
+
+func TestTextBlocks(t *testing.T) {
+	expected := `Release
+
+Highlights
+A    B
+1
+C
+[ Details ]
+
+────────────────────────────────────────
+
+by ci  [image: ci] (https://example.com/ci.png)
+
+[ Open (https://example.com) ] [ Pick ▾ ] [ datepicker ▾ ]
+
+Reason
+[ plain_text_input ▾ ]
+Why?
+
+Latency
+[image: graph] (https://example.com/graph.png)
+
+[file: ABC]
+
+Hi @U1 see docs (https://go.dev):tada:
+1. one
+2. two
+    make
+    make test
+> Feb 18, 2014 14:39 UTC
+
+[video block]
+`
+	assert.Equal(t, expected, Text(testBlocksMessage()))
+}
+
+func TestANSI(t *testing.T) {
+	s := ANSI(testMessage())
+	assert.Contains(t, s, ansiBold+"deploybot"+ansiNoBold)
+	assert.Contains(t, s, "Deploy of "+ansiBold+"api"+ansiNoBold)
+	assert.Contains(t, s, "\x1b[38;2;46;184;134m▌"+ansiNoColor+" ")
+	assert.Contains(t, s, ansiCyan+"12"+ansiNoColor)
+
+	// Columns are aligned ignoring escape sequences.
+	for _, l := range strings.Split(s, "\n") {
+		if strings.Contains(l, "Region") {
+			assert.Equal(t, "▌ Env     Region", reANSI.ReplaceAllString(l, ""))
+		}
+	}
+}
+
+func TestControlCharacters(t *testing.T) {
+	m := &chat.Message{
+		Username: "bot\x1b]0;title\x07",
+		Text:     "\x1b[31mred\x1b[0m `\x1b[2Jcode` <http://x\x1b[1m|li\u009bnk>\tend\r",
+	}
+
+	s := ANSI(m)
+	assert.Equal(t, ansiBold+"bot]0;title"+ansiNoBold+"\n\n"+
+		"[31mred[0m "+ansiCyan+"[2Jcode"+ansiNoColor+" "+
+		ansiUnderline+ansiBlue+"link"+ansiNoColor+ansiNoUnder+" "+ansiGrey+"(http://x[1m)"+ansiNoColor+"\tend\n", s)
+	assert.Equal(t, "bot]0;title\n\n[31mred[0m [2Jcode link (http://x[1m)\tend\n", Text(m))
+}
+
+func TestBarColors(t *testing.T) {
+	st := ansiStyle{}
+	assert.Equal(t, "\x1b[38;2;163;2;0m▌"+ansiNoColor+" ", st.bar("danger"))
+	assert.Equal(t, "\x1b[38;2;255;0;170m▌"+ansiNoColor+" ", st.bar("#ff00aa"))
+	assert.Equal(t, "\x1b[38;2;255;0;170m▌"+ansiNoColor+" ", st.bar("#f0a"))
+	assert.Equal(t, ansiGrey+"▌"+ansiNoColor+" ", st.bar(""))
+	assert.Equal(t, ansiGrey+"▌"+ansiNoColor+" ", st.bar("#zzzzzz"))
+}
@@ -0,0 +1,316 @@
+package render
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// style determines how rendered text is presented.
+// Methods which take rendered text are passed the output of other methods,
+// the remainder are passed raw text which they must sanitize.
+type style interface {
+	// text returns raw text.
+	text(s string) string
+
+	// bold, italic and strike return rendered text with the formatting applied.
+	bold(s string) string
+	italic(s string) string
+	strike(s string) string
+
+	// quote returns rendered text as a block quote.
+	quote(s string) string
+
+	// code and pre return raw text as inline code or a preformatted block.
+	code(s string) string
+	pre(s string) string
+
+	// link returns a link to url displayed as the raw text label.
+	link(url, label string) string
+
+	// mention returns a resolved user, channel or group mention such as @here.
+	mention(s string) string
+
+	// dim returns rendered text as secondary information such as a footer.
+	dim(s string) string
+
+	// br returns a line break.
+	br() string
+
+	// rule returns a horizontal rule.
+	rule() string
+
+	// columns returns rendered left and right side by side.
+	columns(left, right string) string
+
+	// attachment returns the rendered body of an attachment with color,
+	// preceded by the rendered pretext if it's not empty.
+	attachment(color, pretext, body string) string
+
+	// document returns the rendered parts of a message as a whole.
+	document(parts []string) string
+}
+
+// prefix returns s with every line prefixed by p.
+func prefix(s, p string) string {
+	return p + strings.ReplaceAll(s, "\n", "\n"+p)
+}
+
+// sanitize returns s with control characters other than newline and tab
+// removed, so text from a message can't inject escape sequences of its own.
+func sanitize(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '\n' || r == '\t' || !unicode.IsControl(r) {
+			return r
+		}
+		return -1
+	}, s)
+}
+
+// reANSI matches ANSI escape sequences.
+var reANSI = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
+// width returns the number of characters displayed for s.
+func width(s string) int {
+	return utf8.RuneCountInString(reANSI.ReplaceAllString(s, ""))
+}
+
+// columns returns the lines of left and right side by side.
+func columns(left, right string) string {
+	l, r := strings.Split(left, "\n"), strings.Split(right, "\n")
+	var w int
+	for _, s := range l {
+		if n := width(s); n > w {
+			w = n
+		}
+	}
+
+	n := len(l)
+	if len(r) > n {
+		n = len(r)
+	}
+
+	lines := make([]string, n)
+	for i := range lines {
+		var ls, rs string
+		if i < len(l) {
+			ls = l[i]
+		}
+		if i < len(r) {
+			rs = r[i]
+		}
+		lines[i] = strings.TrimRight(ls+strings.Repeat(" ", w-width(ls)+4)+rs, " ")
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// attachment returns body prefixed by bar, preceded by pretext if it's not empty.
+func attachment(bar, pretext, body string) string {
+	s := prefix(body, bar)
+	if pretext != "" {
+		s = pretext + "\n" + s
+	}
+
+	return s
+}
+
+// textStyle renders plain text.
+type textStyle struct{}
+
+func (textStyle) text(s string) string {
+	return sanitize(s)
+}
+
+func (textStyle) bold(s string) string {
+	return s
+}
+
+func (textStyle) italic(s string) string {
+	return s
+}
+
+func (textStyle) strike(s string) string {
+	return s
+}
+
+func (textStyle) quote(s string) string {
+	return prefix(s, "> ")
+}
+
+func (textStyle) code(s string) string {
+	return sanitize(s)
+}
+
+func (textStyle) pre(s string) string {
+	return prefix(sanitize(s), "    ")
+}
+
+func (textStyle) mention(s string) string {
+	return sanitize(s)
+}
+
+func (textStyle) link(url, label string) string {
+	url, label = sanitize(url), sanitize(label)
+	if label == url {
+		return url
+	}
+
+	return label + " (" + url + ")"
+}
+
+func (textStyle) dim(s string) string {
+	return s
+}
+
+func (textStyle) br() string {
+	return "\n"
+}
+
+func (textStyle) rule() string {
+	return strings.Repeat("─", 40)
+}
+
+func (textStyle) columns(left, right string) string {
+	return columns(left, right)
+}
+
+func (st textStyle) attachment(color, pretext, body string) string {
+	return attachment(st.bar(color), pretext, body)
+}
+
+func (textStyle) document(parts []string) string {
+	return strings.Join(parts, "\n\n") + "\n"
+}
+
+// bar returns the bar displayed to the left of an attachment.
+func (textStyle) bar(color string) string {
+	return "| "
+}
+
+// ANSI escape sequences used by ansiStyle.
+const (
+	ansiBold      = "\x1b[1m"
+	ansiNoBold    = "\x1b[22m"
+	ansiItalic    = "\x1b[3m"
+	ansiNoItalic  = "\x1b[23m"
+	ansiUnderline = "\x1b[4m"
+	ansiNoUnder   = "\x1b[24m"
+	ansiStrike    = "\x1b[9m"
+	ansiNoStrike  = "\x1b[29m"
+	ansiGrey      = "\x1b[90m"
+	ansiCyan      = "\x1b[36m"
+	ansiBlue      = "\x1b[34m"
+	ansiNoColor   = "\x1b[39m"
+)
+
+// namedColors maps slack's named attachment colors to RGB.
+var namedColors = map[string]string{
+	"good":    "#2eb886",
+	"warning": "#daa038",
+	"danger":  "#a30200",
+}
+
+// ansiStyle renders text for display in terminals supporting ANSI escape sequences.
+type ansiStyle struct{}
+
+func (ansiStyle) text(s string) string {
+	return sanitize(s)
+}
+
+func (ansiStyle) bold(s string) string {
+	return ansiBold + s + ansiNoBold
+}
+
+func (ansiStyle) italic(s string) string {
+	return ansiItalic + s + ansiNoItalic
+}
+
+func (ansiStyle) strike(s string) string {
+	return ansiStrike + s + ansiNoStrike
+}
+
+func (ansiStyle) quote(s string) string {
+	return prefix(s, ansiGrey+"▌"+ansiNoColor+" ")
+}
+
+func (ansiStyle) code(s string) string {
+	return ansiCyan + sanitize(s) + ansiNoColor
+}
+
+func (ansiStyle) pre(s string) string {
+	return prefix(sanitize(s), "    "+ansiCyan) + ansiNoColor
+}
+
+func (ansiStyle) mention(s string) string {
+	return ansiBold + ansiBlue + sanitize(s) + ansiNoColor + ansiNoBold
+}
+
+func (ansiStyle) link(url, label string) string {
+	url, label = sanitize(url), sanitize(label)
+	s := ansiUnderline + ansiBlue + label + ansiNoColor + ansiNoUnder
+	if label == url {
+		return s
+	}
+
+	return s + " " + ansiGrey + "(" + url + ")" + ansiNoColor
+}
+
+func (ansiStyle) dim(s string) string {
+	return ansiGrey + s + ansiNoColor
+}
+
+func (ansiStyle) br() string {
+	return "\n"
+}
+
+func (st ansiStyle) rule() string {
+	return st.dim(strings.Repeat("─", 40))
+}
+
+func (ansiStyle) columns(left, right string) string {
+	return columns(left, right)
+}
+
+func (st ansiStyle) attachment(color, pretext, body string) string {
+	return attachment(st.bar(color), pretext, body)
+}
+
+func (ansiStyle) document(parts []string) string {
+	return strings.Join(parts, "\n\n") + "\n"
+}
+
+// bar returns the bar displayed to the left of an attachment with color.
+func (ansiStyle) bar(color string) string {
+	if c, ok := namedColors[color]; ok {
+		color = c
+	}
+
+	r, g, b, ok := rgb(color)
+	if !ok {
+		return ansiGrey + "▌" + ansiNoColor + " "
+	}
+
+	return fmt.Sprintf("\x1b[38;2;%d;%d;%dm▌%s ", r, g, b, ansiNoColor)
+}
+
+// rgb parses the hex color s, in the form #rrggbb or #rgb.
+func rgb(s string) (r, g, b uint8, ok bool) {
+	s = strings.TrimPrefix(s, "#")
+	if len(s) == 3 {
+		s = string([]byte{s[0], s[0], s[1], s[1], s[2], s[2]})
+	}
+	if len(s) != 6 {
+		return 0, 0, 0, false
+	}
+
+	v, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		return 0, 0, 0, false
+	}
+
+	return uint8(v >> 16), uint8(v >> 8), uint8(v), true
+}
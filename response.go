@@ -21,3 +21,11 @@ func (r Response) Err() string {
 func (r Response) Warn() string {
 	return r.Warning
 }
+
+// ResponseMetadata is the metadata returned by paginated Web API methods.
+// See: https://api.slack.com/docs/pagination
+type ResponseMetadata struct {
+	// NextCursor is the cursor to pass to fetch the next page of results.
+	// It's empty when there are no more results.
+	NextCursor string `json:"next_cursor,omitempty"`
+}
@@ -0,0 +1,198 @@
+// Package retry provides a slack client which retries failed requests
+// using exponential backoff with jitter.
+//
+// It wraps any slack.Client and honours the Retry-After header returned
+// by Slack when requests are rate limited.
+//
+// See: https://api.slack.com/docs/rate-limits
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"time"
+
+	"github.com/multiplay/go-slack"
+)
+
+var (
+	// DefaultPolicy is the default retry policy.
+	DefaultPolicy = Policy{
+		MaxAttempts:     5,
+		InitialInterval: 500 * time.Millisecond,
+		MaxInterval:     30 * time.Second,
+		Multiplier:      2,
+		Jitter:          0.2,
+		MaxElapsed:      2 * time.Minute,
+	}
+)
+
+// Policy defines how failed requests are retried.
+type Policy struct {
+	// MaxAttempts is the maximum number of attempts including the first.
+	MaxAttempts int
+
+	// InitialInterval is the delay before the first retry.
+	InitialInterval time.Duration
+
+	// MaxInterval caps the delay between attempts, including any jitter.
+	// It doesn't limit delays requested by a Retry-After header.
+	MaxInterval time.Duration
+
+	// Multiplier is the factor the delay is increased by after each attempt.
+	Multiplier float64
+
+	// Jitter is the randomisation factor, between 0 and 1, applied to each delay.
+	// For example 0.2 randomises delays between 80% and 120% of their value.
+	Jitter float64
+
+	// MaxElapsed if non zero is the total time budget for all attempts.
+	// No retry is made if its delay would exceed the budget.
+	MaxElapsed time.Duration
+
+	// Retryable reports if err should be retried.
+	// If nil, Retryable is used.
+	Retryable func(err error) bool
+}
+
+// SetPolicyDefaults sets defaults on the policy if needed to ensure p is valid.
+// MaxElapsed, Jitter and Retryable are left as is as their zero values are valid.
+func SetPolicyDefaults(p *Policy) {
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = DefaultPolicy.MaxAttempts
+	}
+	if p.InitialInterval <= 0 {
+		p.InitialInterval = DefaultPolicy.InitialInterval
+	}
+	if p.MaxInterval <= 0 {
+		p.MaxInterval = DefaultPolicy.MaxInterval
+	}
+	if p.Multiplier < 1 {
+		p.Multiplier = DefaultPolicy.Multiplier
+	}
+}
+
+// Backoff returns the delay to wait after the given failed attempt, starting at 1.
+func (p Policy) Backoff(attempt int) time.Duration {
+	d := float64(p.InitialInterval) * math.Pow(p.Multiplier, float64(attempt-1))
+	if p.Jitter > 0 {
+		d *= 1 + p.Jitter*(2*rand.Float64()-1)
+	}
+	if d > float64(p.MaxInterval) {
+		d = float64(p.MaxInterval)
+	}
+
+	return time.Duration(d)
+}
+
+// Retryable reports if err is worth retrying, that is one which was rate limited,
+// was a server error or has a retryable slack.ErrorCode.
+// See slack.IsRetryable.
+func Retryable(err error) bool {
+	return slack.IsRetryable(err)
+}
+
+// Client is a slack client which retries failed requests made using another client.
+type Client struct {
+	Policy
+	client slack.ContextClient
+}
+
+// New returns a new Client which retries requests made using c according to p.
+// It ensures that p is valid by calling SetPolicyDefaults on it.
+func New(c slack.Client, p Policy) *Client {
+	SetPolicyDefaults(&p)
+
+	return &Client{Policy: p, client: slack.NewContextClient(c)}
+}
+
+// Middleware returns a slack.Middleware which retries requests according to p.
+func Middleware(p Policy) slack.Middleware {
+	return func(c slack.ContextClient) slack.ContextClient {
+		return New(c, p)
+	}
+}
+
+// Send implements slack.Client.
+// It retries failed requests according to the client's Policy.
+func (c *Client) Send(url string, msg, resp interface{}) error {
+	return c.SendContext(context.Background(), url, msg, resp)
+}
+
+// SendContext implements slack.ContextClient.
+// It retries failed requests according to the client's Policy, aborting if ctx is done.
+// If the request fails with a *slack.Error its Attempts is set to the number of attempts made.
+// If ctx is done while waiting to retry the error returned wraps both ctx.Err() and the last error.
+// resp is reset to its zero value before each retry so it only contains the
+// response to the last attempt.
+func (c *Client) SendContext(ctx context.Context, url string, msg, resp interface{}) error {
+	retryable := c.Retryable
+	if retryable == nil {
+		retryable = Retryable
+	}
+
+	start := time.Now()
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 {
+			reset(resp)
+		}
+
+		err := c.client.SendContext(ctx, url, msg, resp)
+		if err == nil {
+			return nil
+		}
+
+		if attempt >= c.MaxAttempts || !retryable(err) {
+			return withAttempts(err, attempt)
+		}
+
+		d := c.delay(attempt, err)
+		if c.MaxElapsed > 0 && time.Since(start)+d > c.MaxElapsed {
+			return withAttempts(err, attempt)
+		}
+
+		t := time.NewTimer(d)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return fmt.Errorf("retry: %w: %w", ctx.Err(), withAttempts(err, attempt))
+		case <-t.C:
+		}
+	}
+}
+
+// delay returns the delay before retrying after attempt failed with err.
+// A Retry-After requested by slack takes precedence over the backoff.
+func (c *Client) delay(attempt int, err error) time.Duration {
+	var serr *slack.Error
+	if errors.As(err, &serr) && serr.RetryAfter > 0 {
+		return serr.RetryAfter
+	}
+
+	return c.Backoff(attempt)
+}
+
+// reset sets the value resp points to, if any, to its zero value so fields
+// decoded from a failed attempt don't survive into the next.
+func reset(resp interface{}) {
+	v := reflect.ValueOf(resp)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return
+	}
+
+	v.Elem().Set(reflect.Zero(v.Elem().Type()))
+}
+
+// withAttempts records attempts on err if it's a *slack.Error and returns it.
+func withAttempts(err error, attempts int) error {
+	var serr *slack.Error
+	if errors.As(err, &serr) {
+		serr.Attempts = attempts
+	}
+
+	return err
+}
@@ -0,0 +1,218 @@
+// Package signature signs and verifies slack requests using the app's
+// signing secret, as slack does for requests such as events, slash
+// commands and interactivity payloads.
+//
+// A Verifier can be used as net/http middleware e.g.
+//
+//	v := signature.NewVerifier(signature.Config{SigningSecret: secret})
+//	http.Handle("/slack/events", v.Middleware(h))
+//
+// A Signer produces requests which pass verification, for testing and tools.
+//
+// See: https://api.slack.com/authentication/verifying-requests-from-slack
+package signature
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// Version is the version of the signature scheme.
+	Version = "v0"
+
+	// SignatureHeader is the header containing the signature of a request.
+	SignatureHeader = "X-Slack-Signature"
+
+	// TimestampHeader is the header containing the unix timestamp of a request.
+	TimestampHeader = "X-Slack-Request-Timestamp"
+)
+
+var (
+	// DefaultMaxAge is the default MaxAge if one is not present in the configuration.
+	DefaultMaxAge = 5 * time.Minute
+
+	// DefaultMaxBodySize is the default MaxBodySize if one is not present in the configuration.
+	DefaultMaxBodySize int64 = 1 << 20
+
+	// ErrInvalidSignature is returned when a request's signature or timestamp is missing or doesn't match.
+	ErrInvalidSignature = errors.New("signature: invalid signature")
+
+	// ErrExpired is returned when a request's timestamp is outside of the replay window.
+	ErrExpired = errors.New("signature: request timestamp outside of replay window")
+
+	// ErrBodyTooLarge is returned when a request's body is larger than MaxBodySize.
+	ErrBodyTooLarge = errors.New("signature: request body too large")
+)
+
+// Sign returns the signature of a request with body sent at ts signed with
+// secret, in the format of the SignatureHeader.
+func Sign(secret string, ts time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	io.WriteString(mac, Version+":"+strconv.FormatInt(ts.Unix(), 10)+":")
+	mac.Write(body)
+
+	return Version + "=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Config is the configuration of a Verifier.
+type Config struct {
+	// SigningSecret is the app's signing secret.
+	// If it's empty all requests fail verification.
+	SigningSecret string
+
+	// MaxAge is the maximum difference between a request's timestamp and
+	// the current time, after which it's rejected to prevent replay attacks.
+	MaxAge time.Duration
+
+	// MaxBodySize is the maximum size of a request body which is read.
+	MaxBodySize int64
+}
+
+// SetConfigDefaults sets defaults on the configuration if needed to ensure the cfg is valid.
+func SetConfigDefaults(cfg *Config) {
+	if cfg.MaxAge == 0 {
+		cfg.MaxAge = DefaultMaxAge
+	}
+	if cfg.MaxBodySize == 0 {
+		cfg.MaxBodySize = DefaultMaxBodySize
+	}
+}
+
+// Verifier verifies the signatures of requests.
+type Verifier struct {
+	Config
+}
+
+// NewVerifier returns a new Verifier with the given configuration.
+// It ensures that the cfg is valid by calling SetConfigDefaults on the cfg.
+func NewVerifier(cfg Config) *Verifier {
+	SetConfigDefaults(&cfg)
+
+	return &Verifier{Config: cfg}
+}
+
+// Verify verifies that the request with headers hdr and body was signed
+// with the signing secret and that its timestamp is within the replay window.
+// Signatures are compared in constant time.
+// It always returns ErrInvalidSignature if SigningSecret is empty, as
+// anyone can sign requests with an empty secret.
+func (v *Verifier) Verify(hdr http.Header, body []byte) error {
+	if v.SigningSecret == "" {
+		return ErrInvalidSignature
+	}
+
+	sec, err := strconv.ParseInt(hdr.Get(TimestampHeader), 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	ts := time.Unix(sec, 0)
+	if d := time.Since(ts); d > v.MaxAge || d < -v.MaxAge {
+		return ErrExpired
+	}
+
+	expected := Sign(v.SigningSecret, ts, body)
+	if !hmac.Equal([]byte(hdr.Get(SignatureHeader)), []byte(expected)) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// VerifyRequest verifies the request r using Verify.
+// The body of r is replaced so it can be read again by the caller.
+func (v *Verifier) VerifyRequest(r *http.Request) error {
+	body, err := readBody(r, v.MaxBodySize)
+	if err != nil {
+		return err
+	}
+
+	return v.Verify(r.Header, body)
+}
+
+// Middleware returns an http.Handler which calls next with requests which
+// pass VerifyRequest, with their body intact, and rejects others with
+// http.StatusUnauthorized.
+func (v *Verifier) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		err := v.VerifyRequest(r)
+		switch {
+		case errors.Is(err, ErrInvalidSignature), errors.Is(err, ErrExpired):
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+		case errors.Is(err, ErrBodyTooLarge):
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		case err != nil:
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		default:
+			next.ServeHTTP(w, r)
+		}
+	})
+}
+
+// Signer signs requests so they pass verification.
+type Signer struct {
+	// SigningSecret is the app's signing secret.
+	SigningSecret string
+}
+
+// NewSigner returns a new Signer which signs requests with secret.
+func NewSigner(secret string) *Signer {
+	return &Signer{SigningSecret: secret}
+}
+
+// Sign signs the request r as if sent now by setting its SignatureHeader
+// and TimestampHeader. The body of r is replaced so it can still be sent.
+func (s *Signer) Sign(r *http.Request) error {
+	return s.SignAt(r, time.Now())
+}
+
+// SignAt signs the request r as if sent at ts by setting its SignatureHeader
+// and TimestampHeader. The body of r is replaced so it can still be sent.
+func (s *Signer) SignAt(r *http.Request, ts time.Time) error {
+	body, err := readBody(r, -1)
+	if err != nil {
+		return err
+	}
+
+	r.Header.Set(TimestampHeader, strconv.FormatInt(ts.Unix(), 10))
+	r.Header.Set(SignatureHeader, Sign(s.SigningSecret, ts, body))
+
+	return nil
+}
+
+// readBody reads and returns the body of r, replacing it so it can be read
+// again. If max isn't negative bodies larger than max return ErrBodyTooLarge.
+func readBody(r *http.Request, max int64) ([]byte, error) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return nil, nil
+	}
+
+	var rd io.Reader = r.Body
+	if max >= 0 {
+		rd = io.LimitReader(r.Body, max+1)
+	}
+	body, err := ioutil.ReadAll(rd)
+	r.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	if max >= 0 && int64(len(body)) > max {
+		return nil, ErrBodyTooLarge
+	}
+
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	r.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(body)), nil
+	}
+
+	return body, nil
+}
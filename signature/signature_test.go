@@ -0,0 +1,159 @@
+package signature_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/multiplay/go-slack/signature"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	// secret and body are the example from the slack documentation.
+	secret = "8f742231b10e8888abcd99yyyzzz85a5"
+	body   = "token=xyzz0WbapA4vBCDEFasx0q6G&team_id=T1DC2JH3J&team_domain=testteamnow&channel_id=G8PSS9T3V&channel_name=foobar&user_id=U2CERLKJA&user_name=roadrunner&command=%2Fwebhook-collect&text=&response_url=https%3A%2F%2Fhooks.slack.com%2Fcommands%2FT1DC2JH3J%2F397700885554%2F96rGlfmibIGlgcZRskXaIFfN&trigger_id=398738663015.47445629121.803a0bc887a14d10d2c447fce8b6703c"
+)
+
+func TestSign(t *testing.T) {
+	sig := Sign(secret, time.Unix(1531420618, 0), []byte(body))
+	assert.Equal(t, "v0=a2114d57b48eac39b9ad189dd8316235a7b4a8d21a10bd27519666489c69b503", sig)
+}
+
+func TestNewVerifier(t *testing.T) {
+	v := NewVerifier(Config{SigningSecret: secret})
+	assert.Equal(t, DefaultMaxAge, v.MaxAge)
+	assert.Equal(t, DefaultMaxBodySize, v.MaxBodySize)
+}
+
+func TestVerify(t *testing.T) {
+	v := NewVerifier(Config{SigningSecret: secret})
+	now := time.Now()
+	hdr := func(ts time.Time, sig string) http.Header {
+		h := http.Header{}
+		h.Set(TimestampHeader, strconv.FormatInt(ts.Unix(), 10))
+		h.Set(SignatureHeader, sig)
+		return h
+	}
+
+	assert.NoError(t, v.Verify(hdr(now, Sign(secret, now, []byte(body))), []byte(body)))
+
+	tests := map[string]struct {
+		hdr  http.Header
+		body string
+		err  error
+	}{
+		"wrong secret":     {hdr: hdr(now, Sign("wrong", now, []byte(body))), body: body, err: ErrInvalidSignature},
+		"modified body":    {hdr: hdr(now, Sign(secret, now, []byte(body))), body: body + "&admin=true", err: ErrInvalidSignature},
+		"wrong timestamp":  {hdr: hdr(now.Add(-time.Second), Sign(secret, now, []byte(body))), body: body, err: ErrInvalidSignature},
+		"missing":          {hdr: http.Header{}, body: body, err: ErrInvalidSignature},
+		"missing sig":      {hdr: hdr(now, ""), body: body, err: ErrInvalidSignature},
+		"expired":          {hdr: hdr(now.Add(-time.Hour), Sign(secret, now.Add(-time.Hour), []byte(body))), body: body, err: ErrExpired},
+		"future":           {hdr: hdr(now.Add(time.Hour), Sign(secret, now.Add(time.Hour), []byte(body))), body: body, err: ErrExpired},
+		"invalid version":  {hdr: hdr(now, strings.Replace(Sign(secret, now, []byte(body)), "v0=", "v1=", 1)), body: body, err: ErrInvalidSignature},
+		"upper case hex":   {hdr: hdr(now, "v0="+strings.ToUpper(strings.TrimPrefix(Sign(secret, now, []byte(body)), "v0="))), body: body, err: ErrInvalidSignature},
+		"signature prefix": {hdr: hdr(now, Sign(secret, now, []byte(body))[:10]), body: body, err: ErrInvalidSignature},
+	}
+	for name, tc := range tests {
+		assert.ErrorIs(t, v.Verify(tc.hdr, []byte(tc.body)), tc.err, name)
+	}
+}
+
+func TestVerifyEmptySecret(t *testing.T) {
+	// Requests signed with an empty secret are trivially forged so never pass.
+	v := NewVerifier(Config{})
+	now := time.Now()
+	h := http.Header{}
+	h.Set(TimestampHeader, strconv.FormatInt(now.Unix(), 10))
+	h.Set(SignatureHeader, Sign("", now, []byte(body)))
+	assert.ErrorIs(t, v.Verify(h, []byte(body)), ErrInvalidSignature)
+
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	if !assert.NoError(t, NewSigner("").Sign(r)) {
+		return
+	}
+	w := httptest.NewRecorder()
+	v.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unverified request passed to handler")
+	})).ServeHTTP(w, r)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestSigner(t *testing.T) {
+	s := NewSigner(secret)
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	if !assert.NoError(t, s.Sign(r)) {
+		return
+	}
+	assert.NotEmpty(t, r.Header.Get(TimestampHeader))
+	assert.True(t, strings.HasPrefix(r.Header.Get(SignatureHeader), "v0="))
+
+	// The body is preserved.
+	b, err := ioutil.ReadAll(r.Body)
+	if assert.NoError(t, err) {
+		assert.Equal(t, body, string(b))
+	}
+
+	// Requests without a body can be signed.
+	r = httptest.NewRequest(http.MethodPost, "/", nil)
+	if assert.NoError(t, s.SignAt(r, time.Unix(1531420618, 0))) {
+		assert.Equal(t, "1531420618", r.Header.Get(TimestampHeader))
+		assert.Equal(t, Sign(secret, time.Unix(1531420618, 0), nil), r.Header.Get(SignatureHeader))
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	v := NewVerifier(Config{SigningSecret: secret, MaxBodySize: int64(len(body))})
+	var got string
+	h := v.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if assert.NoError(t, r.ParseForm()) {
+			got = r.PostForm.Get("command")
+		}
+	}))
+
+	serve := func(r *http.Request) int {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	// The downstream handler can read the body.
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if !assert.NoError(t, NewSigner(secret).Sign(r)) {
+		return
+	}
+	assert.Equal(t, http.StatusOK, serve(r))
+	assert.Equal(t, "/webhook-collect", got)
+
+	got = ""
+	r = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	NewSigner("wrong").Sign(r)
+	assert.Equal(t, http.StatusUnauthorized, serve(r))
+
+	r = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	NewSigner(secret).SignAt(r, time.Now().Add(-time.Hour))
+	assert.Equal(t, http.StatusUnauthorized, serve(r))
+
+	r = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body+"x"))
+	NewSigner(secret).Sign(r)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, serve(r))
+
+	r = httptest.NewRequest(http.MethodPost, "/", errReader{})
+	assert.Equal(t, http.StatusBadRequest, serve(r))
+	assert.Empty(t, got)
+}
+
+// errReader is an io.Reader which always fails.
+type errReader struct{}
+
+// Read implements io.Reader.
+func (errReader) Read(p []byte) (int, error) {
+	return 0, errors.New("read failed")
+}
@@ -0,0 +1,727 @@
+// Package slacktest provides an in-process fake slack server for testing
+// slack clients without network access.
+//
+// The Server emulates the api.test method, the chat Web API methods such as
+// chat.postMessage, chat.update and chat.scheduleMessage, the
+// conversations.replies method and incoming webhooks. Messages posted to it
+// are stored so they can be updated, deleted, fetched and inspected. It
+// records every request it receives so they can be asserted on and supports
+// scripted failures such as error status codes, ok:false responses and delays.
+package slacktest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// apiPrefix is the path prefix of Web API methods.
+	apiPrefix = "/api/"
+
+	// webhookPrefix is the path prefix of incoming webhooks.
+	webhookPrefix = "/services/"
+)
+
+// Request is a request received by the Server.
+type Request struct {
+	// Method is the Web API method called e.g. chat.postMessage.
+	// It's empty for webhook requests.
+	Method string
+
+	// Path is the URL path of the request.
+	Path string
+
+	// Header contains the request headers.
+	Header http.Header
+
+	// Args contains the query string and form arguments of the request.
+	Args url.Values
+
+	// Body is the raw body of the request.
+	Body []byte
+}
+
+// Decode decodes the JSON body of the request into v.
+func (r Request) Decode(v interface{}) error {
+	return json.Unmarshal(r.Body, v)
+}
+
+// Values returns the arguments of the request as a map, merging the top level
+// fields of a JSON object body with any query string or form arguments.
+func (r Request) Values() map[string]interface{} {
+	v := make(map[string]interface{})
+	for k := range r.Args {
+		v[k] = r.Args.Get(k)
+	}
+
+	var body map[string]interface{}
+	json.Unmarshal(r.Body, &body)
+	for k, bv := range body {
+		v[k] = bv
+	}
+
+	return v
+}
+
+// Failure is a scripted failure returned by the Server.
+type Failure struct {
+	// StatusCode if set to other than http.StatusOK is the status code
+	// returned with Error as the body.
+	StatusCode int
+
+	// Error is the slack error code returned.
+	// Web API methods return it as an ok:false response and webhooks
+	// return it as the body with http.StatusBadRequest unless StatusCode is set.
+	Error string
+
+	// Header contains additional headers to send in the response e.g. Retry-After.
+	Header http.Header
+
+	// Delay is the time to wait before responding.
+	Delay time.Duration
+}
+
+// HandlerFunc handles a Web API method request, returning the response
+// which is sent JSON encoded.
+type HandlerFunc func(s *Server, r Request) interface{}
+
+// Server is a fake slack server.
+type Server struct {
+	*httptest.Server
+
+	mtx       sync.Mutex
+	requests  []Request
+	failures  []Failure
+	handlers  map[string]HandlerFunc
+	messages  map[messageKey]map[string]interface{}
+	scheduled []map[string]interface{}
+	sched     int
+	ts        int
+}
+
+// messageKey identifies a message posted to the Server.
+type messageKey struct {
+	channel string
+	ts      string
+}
+
+// NewServer starts and returns a new Server.
+// The caller should call Close when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		handlers: map[string]HandlerFunc{
+			"api.test":           apiTest,
+			"chat.postMessage":   chatPostMessage,
+			"chat.update":        chatUpdate,
+			"chat.delete":        chatDelete,
+			"chat.postEphemeral": chatPostEphemeral,
+			"chat.meMessage":     chatMeMessage,
+			"chat.getPermalink":  chatGetPermalink,
+			"chat.unfurl":        chatUnfurl,
+
+			"chat.scheduleMessage":        chatScheduleMessage,
+			"chat.scheduledMessages.list": chatScheduledMessagesList,
+			"chat.deleteScheduledMessage": chatDeleteScheduledMessage,
+			"conversations.replies":       conversationsReplies,
+		},
+		messages: make(map[messageKey]map[string]interface{}),
+	}
+	s.Server = httptest.NewServer(s)
+
+	return s
+}
+
+// APIURL returns the URL of the Web API method e.g. chat.postMessage.
+func (s *Server) APIURL(method string) string {
+	return s.URL + apiPrefix + method
+}
+
+// WebhookURL returns an incoming webhook URL.
+func (s *Server) WebhookURL() string {
+	return s.URL + webhookPrefix + "T00000000/B00000000/XXXXXXXXXXXXXXXXXXXXXXXX"
+}
+
+// Handle registers h as the handler for the Web API method, replacing any existing handler.
+func (s *Server) Handle(method string, h HandlerFunc) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.handlers[method] = h
+}
+
+// Fail queues failures which are returned, in order, for the next requests.
+func (s *Server) Fail(f ...Failure) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.failures = append(s.failures, f...)
+}
+
+// Requests returns all the requests received by the server.
+func (s *Server) Requests() []Request {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return append([]Request(nil), s.requests...)
+}
+
+// LastRequest returns the last request received by the server.
+// ok is false if no requests have been received.
+func (s *Server) LastRequest() (r Request, ok bool) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if len(s.requests) == 0 {
+		return r, false
+	}
+
+	return s.requests[len(s.requests)-1], true
+}
+
+// Reset clears the recorded requests, stored and scheduled messages and any pending failures.
+func (s *Server) Reset() {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.requests = nil
+	s.failures = nil
+	s.messages = make(map[messageKey]map[string]interface{})
+	s.scheduled = nil
+}
+
+// Message returns the current fields of the message with timestamp ts
+// posted to channel, reflecting any updates, and true if it exists.
+func (s *Server) Message(channel, ts string) (map[string]interface{}, bool) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	msg, ok := s.messages[messageKey{channel: channel, ts: ts}]
+	return msg, ok
+}
+
+// storeMessage stores msg as the message with timestamp ts in channel.
+func (s *Server) storeMessage(channel, ts string, msg map[string]interface{}) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.messages[messageKey{channel: channel, ts: ts}] = msg
+}
+
+// deleteMessage deletes the message with timestamp ts in channel,
+// returning true if it existed.
+func (s *Server) deleteMessage(channel, ts string) bool {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	k := messageKey{channel: channel, ts: ts}
+	_, ok := s.messages[k]
+	delete(s.messages, k)
+
+	return ok
+}
+
+// Scheduled returns the messages which are scheduled to be posted in the
+// order they were scheduled.
+// Scheduled messages are never posted by the Server.
+func (s *Server) Scheduled() []map[string]interface{} {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return append([]map[string]interface{}(nil), s.scheduled...)
+}
+
+// NextTimestamp returns a new unique message timestamp.
+func (s *Server) NextTimestamp() string {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.ts++
+	return fmt.Sprintf("%d.%06d", time.Now().Unix(), s.ts)
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := ioutil.ReadAll(r.Body)
+	req := Request{Path: r.URL.Path, Header: r.Header.Clone(), Body: body}
+	if strings.HasPrefix(r.URL.Path, apiPrefix) {
+		req.Method = strings.TrimPrefix(r.URL.Path, apiPrefix)
+	}
+
+	req.Args = r.URL.Query()
+	if ct := r.Header.Get("Content-Type"); strings.HasPrefix(ct, "application/x-www-form-urlencoded") {
+		if v, err := url.ParseQuery(string(body)); err == nil {
+			for k, vs := range v {
+				req.Args[k] = append(req.Args[k], vs...)
+			}
+		}
+	}
+
+	f := s.record(req)
+	if f != nil {
+		if f.Delay > 0 {
+			select {
+			case <-r.Context().Done():
+				return
+			case <-time.After(f.Delay):
+			}
+		}
+		for k, vs := range f.Header {
+			w.Header()[k] = vs
+		}
+	}
+
+	switch {
+	case strings.HasPrefix(req.Path, webhookPrefix):
+		s.serveWebhook(w, f)
+	case req.Method != "":
+		s.serveAPI(w, req, f)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// record records req and returns the next failure if any.
+func (s *Server) record(req Request) *Failure {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.requests = append(s.requests, req)
+	if len(s.failures) == 0 {
+		return nil
+	}
+
+	f := s.failures[0]
+	s.failures = s.failures[1:]
+	return &f
+}
+
+// serveWebhook responds to an incoming webhook request.
+func (s *Server) serveWebhook(w http.ResponseWriter, f *Failure) {
+	status, body := http.StatusOK, "ok"
+	if f != nil && f.Error != "" {
+		status, body = http.StatusBadRequest, f.Error
+	}
+	if f != nil && f.StatusCode != 0 {
+		status = f.StatusCode
+	}
+
+	w.Header().Set("Content-Type", "text/html")
+	w.WriteHeader(status)
+	w.Write([]byte(body))
+}
+
+// serveAPI responds to a Web API method request.
+func (s *Server) serveAPI(w http.ResponseWriter, req Request, f *Failure) {
+	if f != nil && f.StatusCode != 0 && f.StatusCode != http.StatusOK {
+		w.WriteHeader(f.StatusCode)
+		w.Write([]byte(f.Error))
+		return
+	}
+
+	var resp interface{}
+	switch {
+	case f != nil && f.Error != "":
+		resp = NewError(f.Error)
+	default:
+		s.mtx.Lock()
+		h, ok := s.handlers[req.Method]
+		s.mtx.Unlock()
+		if ok {
+			resp = h(s, req)
+		} else {
+			resp = NewError("unknown_method")
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// NewError returns an ok:false response with the error code err.
+func NewError(err string) map[string]interface{} {
+	return map[string]interface{}{"ok": false, "error": err}
+}
+
+// apiTest emulates the api.test method which echoes its arguments
+// and returns the error specified by the error argument.
+func apiTest(s *Server, r Request) interface{} {
+	args := r.Values()
+	resp := map[string]interface{}{"ok": true, "args": args}
+	if err, ok := args["error"]; ok {
+		resp["ok"] = false
+		resp["error"] = err
+	}
+
+	return resp
+}
+
+// chatPostMessage emulates the chat.postMessage method.
+func chatPostMessage(s *Server, r Request) interface{} {
+	msg := r.Values()
+	ch, _ := msg["channel"].(string)
+	if ch == "" {
+		return NewError("channel_not_found")
+	}
+	if msg["text"] == nil && msg["blocks"] == nil && msg["attachments"] == nil {
+		return NewError("no_text")
+	}
+
+	ts := s.NextTimestamp()
+	delete(msg, "channel")
+	delete(msg, "token")
+	msg["type"] = "message"
+	msg["ts"] = ts
+	s.storeMessage(ch, ts, msg)
+
+	return map[string]interface{}{"ok": true, "channel": ch, "ts": ts, "message": msg}
+}
+
+// chatUpdate emulates the chat.update method, replacing the fields of a
+// message previously posted with chat.postMessage.
+func chatUpdate(s *Server, r Request) interface{} {
+	msg := r.Values()
+	ch, _ := msg["channel"].(string)
+	if ch == "" {
+		return NewError("channel_not_found")
+	}
+	ts, _ := msg["ts"].(string)
+	if _, ok := s.Message(ch, ts); !ok {
+		return NewError("message_not_found")
+	}
+	if msg["text"] == nil && msg["blocks"] == nil && msg["attachments"] == nil {
+		return NewError("no_text")
+	}
+
+	delete(msg, "channel")
+	delete(msg, "token")
+	msg["type"] = "message"
+	s.storeMessage(ch, ts, msg)
+
+	return map[string]interface{}{"ok": true, "channel": ch, "ts": ts, "text": msg["text"], "message": msg}
+}
+
+// chatDelete emulates the chat.delete method, deleting a message
+// previously posted to the Server.
+func chatDelete(s *Server, r Request) interface{} {
+	args := r.Values()
+	ch, _ := args["channel"].(string)
+	if ch == "" {
+		return NewError("channel_not_found")
+	}
+	ts, _ := args["ts"].(string)
+	if !s.deleteMessage(ch, ts) {
+		return NewError("message_not_found")
+	}
+
+	return map[string]interface{}{"ok": true, "channel": ch, "ts": ts}
+}
+
+// chatPostEphemeral emulates the chat.postEphemeral method.
+// Ephemeral messages aren't stored.
+func chatPostEphemeral(s *Server, r Request) interface{} {
+	msg := r.Values()
+	if ch, _ := msg["channel"].(string); ch == "" {
+		return NewError("channel_not_found")
+	}
+	if u, _ := msg["user"].(string); u == "" {
+		return NewError("user_not_found")
+	}
+	if msg["text"] == nil && msg["blocks"] == nil && msg["attachments"] == nil {
+		return NewError("no_text")
+	}
+
+	return map[string]interface{}{"ok": true, "message_ts": s.NextTimestamp()}
+}
+
+// chatMeMessage emulates the chat.meMessage method.
+func chatMeMessage(s *Server, r Request) interface{} {
+	args := r.Values()
+	ch, _ := args["channel"].(string)
+	if ch == "" {
+		return NewError("channel_not_found")
+	}
+	text, _ := args["text"].(string)
+	if text == "" {
+		return NewError("no_text")
+	}
+
+	ts := s.NextTimestamp()
+	s.storeMessage(ch, ts, map[string]interface{}{"type": "message", "subtype": "me_message", "text": text, "ts": ts})
+
+	return map[string]interface{}{"ok": true, "channel": ch, "ts": ts}
+}
+
+// chatGetPermalink emulates the chat.getPermalink method.
+// Permalinks are URLs of the Server in the format used by slack.
+func chatGetPermalink(s *Server, r Request) interface{} {
+	args := r.Values()
+	ch, _ := args["channel"].(string)
+	if ch == "" {
+		return NewError("channel_not_found")
+	}
+	ts, _ := args["message_ts"].(string)
+	msg, ok := s.Message(ch, ts)
+	if !ok {
+		return NewError("message_not_found")
+	}
+
+	link := s.URL + "/archives/" + ch + "/p" + strings.Replace(ts, ".", "", 1)
+	if tts, _ := msg["thread_ts"].(string); tts != "" {
+		link += "?" + url.Values{"thread_ts": {tts}, "cid": {ch}}.Encode()
+	}
+
+	return map[string]interface{}{"ok": true, "channel": ch, "permalink": link}
+}
+
+// chatUnfurl emulates the chat.unfurl method, adding the unfurls to the
+// message as attachments with from_url set to the URL they unfurl.
+// Unfurls identified by unfurl_id and source are accepted but not stored.
+func chatUnfurl(s *Server, r Request) interface{} {
+	args := r.Values()
+	unfurls, ok := args["unfurls"].(map[string]interface{})
+	if !ok || len(unfurls) == 0 {
+		return NewError("invalid_unfurls_format")
+	}
+	if id, _ := args["unfurl_id"].(string); id != "" && args["source"] != nil {
+		return map[string]interface{}{"ok": true}
+	}
+
+	ch, _ := args["channel"].(string)
+	if ch == "" {
+		return NewError("channel_not_found")
+	}
+	ts, _ := args["ts"].(string)
+	msg, ok := s.Message(ch, ts)
+	if !ok {
+		return NewError("message_not_found")
+	}
+
+	urls := make([]string, 0, len(unfurls))
+	for u := range unfurls {
+		urls = append(urls, u)
+	}
+	sort.Strings(urls)
+
+	// Copy the message so it isn't modified while it may be being read.
+	um := make(map[string]interface{}, len(msg)+1)
+	for k, v := range msg {
+		um[k] = v
+	}
+	atts, _ := msg["attachments"].([]interface{})
+	atts = append([]interface{}(nil), atts...)
+	for _, u := range urls {
+		a, ok := unfurls[u].(map[string]interface{})
+		if !ok {
+			return NewError("invalid_unfurls_format")
+		}
+		ua := map[string]interface{}{"from_url": u}
+		for k, v := range a {
+			ua[k] = v
+		}
+		atts = append(atts, ua)
+	}
+	um["attachments"] = atts
+	s.storeMessage(ch, ts, um)
+
+	return map[string]interface{}{"ok": true}
+}
+
+// maxScheduleAhead is how far in the future messages can be scheduled.
+const maxScheduleAhead = 120 * 24 * time.Hour
+
+// chatScheduleMessage emulates the chat.scheduleMessage method.
+func chatScheduleMessage(s *Server, r Request) interface{} {
+	msg := r.Values()
+	ch, _ := msg["channel"].(string)
+	if ch == "" {
+		return NewError("channel_not_found")
+	}
+
+	postAt, ok := integer(msg["post_at"])
+	now := time.Now()
+	switch {
+	case !ok || postAt <= 0:
+		return NewError("invalid_time")
+	case postAt < now.Unix():
+		return NewError("time_in_past")
+	case postAt > now.Add(maxScheduleAhead).Unix():
+		return NewError("time_too_far")
+	}
+	if msg["text"] == nil && msg["blocks"] == nil && msg["attachments"] == nil {
+		return NewError("no_text")
+	}
+
+	s.mtx.Lock()
+	s.sched++
+	id := fmt.Sprintf("Q%08d", s.sched)
+	text, _ := msg["text"].(string)
+	s.scheduled = append(s.scheduled, map[string]interface{}{
+		"id":           id,
+		"channel_id":   ch,
+		"post_at":      postAt,
+		"date_created": now.Unix(),
+		"text":         text,
+	})
+	s.mtx.Unlock()
+
+	for _, k := range []string{"channel", "token", "post_at"} {
+		delete(msg, k)
+	}
+	msg["type"] = "message"
+
+	return map[string]interface{}{
+		"ok":                   true,
+		"channel":              ch,
+		"scheduled_message_id": id,
+		"post_at":              strconv.FormatInt(postAt, 10),
+		"message":              msg,
+	}
+}
+
+// chatScheduledMessagesList emulates the chat.scheduledMessages.list method.
+func chatScheduledMessagesList(s *Server, r Request) interface{} {
+	args := r.Values()
+	ch, _ := args["channel"].(string)
+
+	matched := []map[string]interface{}{}
+	for _, sm := range s.Scheduled() {
+		if ch == "" || sm["channel_id"] == ch {
+			matched = append(matched, sm)
+		}
+	}
+
+	start, end, next, ok := paginate(args, len(matched), 100)
+	if !ok {
+		return NewError("invalid_cursor")
+	}
+
+	return map[string]interface{}{
+		"ok":                 true,
+		"scheduled_messages": matched[start:end],
+		"response_metadata":  map[string]interface{}{"next_cursor": next},
+	}
+}
+
+// chatDeleteScheduledMessage emulates the chat.deleteScheduledMessage method.
+func chatDeleteScheduledMessage(s *Server, r Request) interface{} {
+	args := r.Values()
+	ch, _ := args["channel"].(string)
+	if ch == "" {
+		return NewError("channel_not_found")
+	}
+	id, _ := args["scheduled_message_id"].(string)
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	for i, sm := range s.scheduled {
+		if sm["id"] == id && sm["channel_id"] == ch {
+			s.scheduled = append(s.scheduled[:i:i], s.scheduled[i+1:]...)
+			return map[string]interface{}{"ok": true}
+		}
+	}
+
+	return NewError("invalid_scheduled_message_id")
+}
+
+// integer returns the integer value of v which may be a JSON number or a string.
+func integer(v interface{}) (int64, bool) {
+	switch v := v.(type) {
+	case float64:
+		return int64(v), true
+	case string:
+		i, err := strconv.ParseInt(v, 10, 64)
+		return i, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// conversationsReplies emulates the conversations.replies method, returning
+// the parent message identified by ts and its replies in order.
+// Metadata is only included if requested with include_all_metadata.
+func conversationsReplies(s *Server, r Request) interface{} {
+	args := r.Values()
+	ch, _ := args["channel"].(string)
+	if ch == "" {
+		return NewError("channel_not_found")
+	}
+	ts, _ := args["ts"].(string)
+	if _, ok := s.Message(ch, ts); !ok {
+		return NewError("thread_not_found")
+	}
+
+	s.mtx.Lock()
+	msgs := []map[string]interface{}{}
+	for k, msg := range s.messages {
+		if k.channel == ch && (k.ts == ts || msg["thread_ts"] == ts) {
+			msgs = append(msgs, msg)
+		}
+	}
+	s.mtx.Unlock()
+
+	if args["include_all_metadata"] != "true" {
+		for i, msg := range msgs {
+			if _, ok := msg["metadata"]; ok {
+				m := make(map[string]interface{}, len(msg))
+				for k, v := range msg {
+					m[k] = v
+				}
+				delete(m, "metadata")
+				msgs[i] = m
+			}
+		}
+	}
+
+	sort.Slice(msgs, func(i, j int) bool {
+		return fmt.Sprint(msgs[i]["ts"]) < fmt.Sprint(msgs[j]["ts"])
+	})
+
+	start, end, next, ok := paginate(args, len(msgs), 1000)
+	if !ok {
+		return NewError("invalid_cursor")
+	}
+
+	return map[string]interface{}{
+		"ok":                true,
+		"messages":          msgs[start:end],
+		"has_more":          next != "",
+		"response_metadata": map[string]interface{}{"next_cursor": next},
+	}
+}
+
+// paginate returns the range of the page of n results requested by the
+// cursor and limit arguments of args, using def if no limit is given,
+// and the cursor of the next page or "" if it's the last.
+// The Server's cursors are the offset of the page.
+// It returns false if the cursor is invalid.
+func paginate(args map[string]interface{}, n, def int) (start, end int, next string, ok bool) {
+	limit, ok := integer(args["limit"])
+	if !ok || limit <= 0 {
+		limit = int64(def)
+	}
+
+	if c, _ := args["cursor"].(string); c != "" {
+		var err error
+		if start, err = strconv.Atoi(c); err != nil || start < 0 || start > n {
+			return 0, 0, "", false
+		}
+	}
+
+	end = n
+	if int64(end-start) > limit {
+		end = start + int(limit)
+		next = strconv.Itoa(end)
+	}
+
+	return start, end, next, true
+}
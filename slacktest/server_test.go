@@ -0,0 +1,437 @@
+package slacktest_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiplay/go-slack"
+	"github.com/multiplay/go-slack/api"
+	"github.com/multiplay/go-slack/chat"
+	. "github.com/multiplay/go-slack/slacktest"
+	"github.com/multiplay/go-slack/webhook"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPITest(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	c := webhook.New(s.APIURL("api.test") + "?foo=bar")
+	resp := &struct {
+		slack.Response
+		Args map[string]interface{} `json:"args"`
+	}{}
+	err := c.Send("", map[string]int{"count": 2}, resp)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.True(t, resp.OK)
+	assert.Equal(t, map[string]interface{}{"foo": "bar", "count": float64(2)}, resp.Args)
+}
+
+func TestAPITestError(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	c := webhook.New(s.APIURL("api.test") + "?error=no_text")
+	err := c.Send("", nil, &slack.Response{})
+	assert.True(t, errors.Is(err, slack.ErrNoText))
+}
+
+func TestChatPostMessage(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	c := api.New("xoxb-token")
+	m := &chat.Message{Channel: "C123", Text: "test message"}
+	resp := &chat.MessageResponse{}
+	err := c.Send(s.APIURL("chat.postMessage"), m, resp)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "C123", resp.Channel)
+	assert.NotEmpty(t, resp.Timestamp)
+	if assert.NotNil(t, resp.Message) {
+		assert.Equal(t, "test message", resp.Message.Text)
+	}
+
+	r, ok := s.LastRequest()
+	if !assert.True(t, ok) {
+		return
+	}
+	assert.Equal(t, "chat.postMessage", r.Method)
+	assert.Equal(t, "Bearer xoxb-token", r.Header.Get("Authorization"))
+
+	sent := &chat.Message{}
+	if assert.NoError(t, r.Decode(sent)) {
+		assert.Equal(t, m, sent)
+	}
+}
+
+func TestChatPostMessageErrors(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	c := api.New("xoxb-token")
+	err := c.Send(s.APIURL("chat.postMessage"), &chat.Message{Text: "test message"}, &chat.MessageResponse{})
+	assert.True(t, errors.Is(err, slack.ErrChannelNotFound))
+
+	err = c.Send(s.APIURL("chat.postMessage"), &chat.Message{Channel: "C123"}, &chat.MessageResponse{})
+	assert.True(t, errors.Is(err, slack.ErrNoText))
+}
+
+func TestChatUpdate(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	c := api.New("xoxb-token")
+	resp := &chat.MessageResponse{}
+	err := c.Send(s.APIURL("chat.postMessage"), &chat.Message{Channel: "C123", Text: "before"}, resp)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	u := &chat.Update{Message: chat.Message{Channel: "C123", Text: "after"}, Timestamp: resp.Timestamp}
+	uresp := &chat.MessageResponse{}
+	err = c.Send(s.APIURL("chat.update"), u, uresp)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, resp.Timestamp, uresp.Timestamp)
+	if assert.NotNil(t, uresp.Message) {
+		assert.Equal(t, "after", uresp.Message.Text)
+	}
+
+	msg, ok := s.Message("C123", resp.Timestamp)
+	if assert.True(t, ok) {
+		assert.Equal(t, "after", msg["text"])
+	}
+
+	s.Reset()
+	_, ok = s.Message("C123", resp.Timestamp)
+	assert.False(t, ok)
+}
+
+func TestChatUpdateErrors(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	c := api.New("xoxb-token")
+	resp := &chat.MessageResponse{}
+	err := c.Send(s.APIURL("chat.postMessage"), &chat.Message{Channel: "C123", Text: "before"}, resp)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	u := &chat.Update{Message: chat.Message{Text: "after"}, Timestamp: resp.Timestamp}
+	err = c.Send(s.APIURL("chat.update"), u, &chat.MessageResponse{})
+	assert.True(t, errors.Is(err, slack.ErrChannelNotFound))
+
+	u = &chat.Update{Message: chat.Message{Channel: "C123", Text: "after"}, Timestamp: "1.000000"}
+	err = c.Send(s.APIURL("chat.update"), u, &chat.MessageResponse{})
+	assert.True(t, errors.Is(err, slack.ErrMessageNotFound))
+
+	u = &chat.Update{Message: chat.Message{Channel: "C123"}, Timestamp: resp.Timestamp}
+	err = c.Send(s.APIURL("chat.update"), u, &chat.MessageResponse{})
+	assert.True(t, errors.Is(err, slack.ErrNoText))
+}
+
+func TestChatDelete(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	c := api.New("xoxb-token")
+	resp := &chat.MessageResponse{}
+	err := c.Send(s.APIURL("chat.postMessage"), &chat.Message{Channel: "C123", Text: "test message"}, resp)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	d := &chat.Delete{Channel: "C123", Timestamp: resp.Timestamp}
+	dresp := &chat.DeleteResponse{}
+	if !assert.NoError(t, c.Send(s.APIURL("chat.delete"), d, dresp)) {
+		return
+	}
+	assert.Equal(t, resp.Timestamp, dresp.Timestamp)
+
+	_, ok := s.Message("C123", resp.Timestamp)
+	assert.False(t, ok)
+
+	err = c.Send(s.APIURL("chat.delete"), d, &chat.DeleteResponse{})
+	assert.True(t, errors.Is(err, slack.ErrMessageNotFound))
+}
+
+func TestChatPostEphemeral(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	c := api.New("xoxb-token")
+	e := &chat.Ephemeral{Message: chat.Message{Channel: "C123", Text: "hint"}, User: "U123"}
+	resp := &chat.EphemeralResponse{}
+	if !assert.NoError(t, c.Send(s.APIURL("chat.postEphemeral"), e, resp)) {
+		return
+	}
+	assert.NotEmpty(t, resp.MessageTimestamp)
+
+	e.User = ""
+	err := c.Send(s.APIURL("chat.postEphemeral"), e, &chat.EphemeralResponse{})
+	assert.True(t, errors.Is(err, slack.ErrUserNotFound))
+}
+
+func TestChatMeMessage(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	c := api.New("xoxb-token")
+	resp := &chat.MessageResponse{}
+	if !assert.NoError(t, c.Send(s.APIURL("chat.meMessage"), &chat.MeMessage{Channel: "C123", Text: "waves"}, resp)) {
+		return
+	}
+
+	msg, ok := s.Message("C123", resp.Timestamp)
+	if assert.True(t, ok) {
+		assert.Equal(t, "me_message", msg["subtype"])
+		assert.Equal(t, "waves", msg["text"])
+	}
+
+	err := c.Send(s.APIURL("chat.meMessage"), &chat.MeMessage{Text: "waves"}, &chat.MessageResponse{})
+	assert.True(t, errors.Is(err, slack.ErrChannelNotFound))
+}
+
+func TestChatScheduleMessage(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	c := api.New("xoxb-token")
+	sm := &chat.Schedule{Message: chat.Message{Channel: "C123", Text: "reminder"}, PostAt: time.Now().Add(time.Hour)}
+	resp := &chat.ScheduleResponse{}
+	if !assert.NoError(t, c.Send(s.APIURL("chat.scheduleMessage"), sm, resp)) {
+		return
+	}
+
+	scheduled := s.Scheduled()
+	if assert.Len(t, scheduled, 1) {
+		assert.Equal(t, resp.ScheduledMessageID, scheduled[0]["id"])
+		assert.Equal(t, "reminder", scheduled[0]["text"])
+	}
+
+	list := &chat.ScheduledListResponse{}
+	if assert.NoError(t, c.Send(s.APIURL("chat.scheduledMessages.list"), &chat.ScheduledList{Channel: "C999"}, list)) {
+		assert.Empty(t, list.ScheduledMessages)
+	}
+
+	d := &chat.DeleteScheduled{Channel: "C123", ScheduledMessageID: resp.ScheduledMessageID}
+	if assert.NoError(t, c.Send(s.APIURL("chat.deleteScheduledMessage"), d, &slack.Response{})) {
+		assert.Empty(t, s.Scheduled())
+	}
+
+	err := c.Send(s.APIURL("chat.deleteScheduledMessage"), d, &slack.Response{})
+	assert.True(t, errors.Is(err, slack.ErrInvalidScheduledMessageID))
+}
+
+func TestConversationsReplies(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	c := api.New("xoxb-token")
+	parent := &chat.MessageResponse{}
+	if !assert.NoError(t, c.Send(s.APIURL("chat.postMessage"), &chat.Message{Channel: "C123", Text: "parent"}, parent)) {
+		return
+	}
+	for _, text := range []string{"a", "b"} {
+		m := &chat.Message{Channel: "C123", Text: text, ThreadTS: parent.Timestamp}
+		if !assert.NoError(t, c.Send(s.APIURL("chat.postMessage"), m, &chat.MessageResponse{})) {
+			return
+		}
+	}
+	// Not part of the thread.
+	if !assert.NoError(t, c.Send(s.APIURL("chat.postMessage"), &chat.Message{Channel: "C123", Text: "other"}, &chat.MessageResponse{})) {
+		return
+	}
+
+	v := url.Values{"channel": {"C123"}, "ts": {parent.Timestamp}, "limit": {"2"}}
+	resp := &chat.RepliesResponse{}
+	if !assert.NoError(t, c.Send(s.APIURL("conversations.replies"), v, resp)) {
+		return
+	}
+	if assert.Len(t, resp.Messages, 2) {
+		assert.Equal(t, "parent", resp.Messages[0].Text)
+		assert.Equal(t, "a", resp.Messages[1].Text)
+	}
+	assert.True(t, resp.HasMore)
+
+	v.Set("cursor", resp.ResponseMetadata.NextCursor)
+	resp = &chat.RepliesResponse{}
+	if !assert.NoError(t, c.Send(s.APIURL("conversations.replies"), v, resp)) {
+		return
+	}
+	if assert.Len(t, resp.Messages, 1) {
+		assert.Equal(t, "b", resp.Messages[0].Text)
+	}
+	assert.False(t, resp.HasMore)
+
+	v.Set("cursor", "bogus")
+	err := c.Send(s.APIURL("conversations.replies"), v, &chat.RepliesResponse{})
+	assert.True(t, errors.Is(err, slack.ErrInvalidCursor))
+
+	v = url.Values{"channel": {"C123"}, "ts": {"1.000000"}}
+	err = c.Send(s.APIURL("conversations.replies"), v, &chat.RepliesResponse{})
+	assert.True(t, errors.Is(err, slack.ErrThreadNotFound))
+}
+
+func TestChatGetPermalink(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	c := api.New("xoxb-token")
+	parent := &chat.MessageResponse{}
+	if !assert.NoError(t, c.Send(s.APIURL("chat.postMessage"), &chat.Message{Channel: "C123", Text: "parent"}, parent)) {
+		return
+	}
+	reply := &chat.MessageResponse{}
+	if !assert.NoError(t, c.Send(s.APIURL("chat.postMessage"), &chat.Message{Channel: "C123", Text: "reply", ThreadTS: parent.Timestamp}, reply)) {
+		return
+	}
+
+	v := url.Values{"channel": {"C123"}, "message_ts": {parent.Timestamp}}
+	resp := &chat.PermalinkResponse{}
+	if assert.NoError(t, c.Send(s.APIURL("chat.getPermalink"), v, resp)) {
+		assert.Equal(t, s.URL+"/archives/C123/p"+strings.Replace(parent.Timestamp, ".", "", 1), resp.Permalink)
+	}
+
+	v.Set("message_ts", reply.Timestamp)
+	if assert.NoError(t, c.Send(s.APIURL("chat.getPermalink"), v, resp)) {
+		assert.Contains(t, resp.Permalink, "thread_ts="+parent.Timestamp)
+	}
+
+	v.Set("message_ts", "1.000000")
+	err := c.Send(s.APIURL("chat.getPermalink"), v, &chat.PermalinkResponse{})
+	assert.True(t, errors.Is(err, slack.ErrMessageNotFound))
+}
+
+func TestWebhook(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	c := webhook.New(s.WebhookURL())
+	m := &chat.Message{Text: "test message"}
+	_, err := m.Send(c)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	reqs := s.Requests()
+	if !assert.Len(t, reqs, 1) {
+		return
+	}
+	assert.Empty(t, reqs[0].Method)
+
+	sent := &chat.Message{}
+	if assert.NoError(t, reqs[0].Decode(sent)) {
+		assert.Equal(t, m, sent)
+	}
+}
+
+func TestFail(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	s.Fail(
+		Failure{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": {"5"}}},
+		Failure{Error: "invalid_auth"},
+		Failure{Error: "channel_not_found"},
+	)
+
+	c := api.New("xoxb-token")
+	err := c.Send(s.APIURL("api.test"), nil, &slack.Response{})
+	var serr *slack.Error
+	if assert.True(t, errors.As(err, &serr)) {
+		assert.Equal(t, http.StatusTooManyRequests, serr.StatusCode)
+		assert.Equal(t, 5*time.Second, serr.RetryAfter)
+	}
+
+	err = c.Send(s.APIURL("api.test"), nil, &slack.Response{})
+	assert.True(t, errors.Is(err, slack.ErrInvalidAuth))
+
+	w := webhook.New(s.WebhookURL())
+	err = w.Send("", nil, &slack.Response{})
+	if assert.True(t, errors.As(err, &serr)) {
+		assert.Equal(t, http.StatusBadRequest, serr.StatusCode)
+		assert.True(t, errors.Is(err, slack.ErrChannelNotFound))
+	}
+
+	assert.NoError(t, c.Send(s.APIURL("api.test"), nil, &slack.Response{}))
+	assert.Len(t, s.Requests(), 4)
+}
+
+func TestFailDelay(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	s.Fail(Failure{Delay: time.Second})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	c := api.New("xoxb-token")
+	err := c.SendContext(ctx, s.APIURL("api.test"), nil, &slack.Response{})
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestHandle(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	s.Handle("custom.method", func(s *Server, r Request) interface{} {
+		return map[string]interface{}{"ok": true, "warning": "my warning"}
+	})
+
+	c := api.New("xoxb-token")
+	resp := &slack.Response{}
+	assert.NoError(t, c.Send(s.APIURL("custom.method"), nil, resp))
+	assert.Equal(t, "my warning", resp.Warning)
+
+	err := c.Send(s.APIURL("unknown.method"), nil, resp)
+	assert.True(t, errors.Is(err, slack.ErrorCode("unknown_method")))
+
+	err = c.Send(s.URL+"/invalid", nil, resp)
+	var serr *slack.Error
+	if assert.True(t, errors.As(err, &serr)) {
+		assert.Equal(t, http.StatusNotFound, serr.StatusCode)
+	}
+}
+
+func TestReset(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	_, ok := s.LastRequest()
+	assert.False(t, ok)
+
+	s.Fail(Failure{Error: "invalid_auth"})
+	c := api.New("xoxb-token")
+	assert.Error(t, c.Send(s.APIURL("api.test"), nil, &slack.Response{}))
+	s.Fail(Failure{Error: "invalid_auth"})
+
+	s.Reset()
+	assert.Empty(t, s.Requests())
+	assert.NoError(t, c.Send(s.APIURL("api.test"), nil, &slack.Response{}))
+}
+
+func ExampleNewServer() {
+	s := NewServer()
+	defer s.Close()
+
+	c := webhook.New(s.WebhookURL())
+	m := &chat.Message{Text: "test message"}
+	m.Send(c)
+
+	r, _ := s.LastRequest()
+	sent := &chat.Message{}
+	r.Decode(sent)
+}
@@ -0,0 +1,123 @@
+package slash
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/multiplay/go-slack"
+	"github.com/multiplay/go-slack/webhook"
+)
+
+var (
+	// ErrNoCommand is returned by Parse when the request doesn't contain a command.
+	ErrNoCommand = errors.New("slash: no command")
+
+	// ErrNoResponseURL is returned by Respond when the command has no response_url.
+	ErrNoResponseURL = errors.New("slash: no response_url")
+)
+
+// Command is a slash command invocation sent by slack.
+//
+// See: https://api.slack.com/interactivity/slash-commands#app_command_handling
+type Command struct {
+	// Command is the command invoked e.g. /deploy.
+	Command string
+
+	// Text is the text which followed the command.
+	Text string
+
+	// Subcommand is the subcommand matched by a Router, the first word of Text.
+	// It's empty if the command was handled without a subcommand.
+	Subcommand string
+
+	// Args is the text which followed Subcommand when routed by a Router, otherwise Text.
+	Args string
+
+	// UserID is the ID of the user who invoked the command.
+	UserID string
+
+	// UserName is the name of the user who invoked the command.
+	UserName string
+
+	// ChannelID is the ID of the channel the command was invoked in.
+	ChannelID string
+
+	// ChannelName is the name of the channel the command was invoked in.
+	ChannelName string
+
+	// TeamID is the ID of the workspace the command was invoked in.
+	TeamID string
+
+	// TeamDomain is the domain of the workspace the command was invoked in.
+	TeamDomain string
+
+	// EnterpriseID is the ID of the enterprise grid, if the workspace is part of one.
+	EnterpriseID string
+
+	// EnterpriseName is the name of the enterprise grid, if the workspace is part of one.
+	EnterpriseName string
+
+	// IsEnterpriseInstall indicates if the app is installed across the enterprise grid.
+	IsEnterpriseInstall bool
+
+	// APIAppID is the ID of the app the command is for.
+	APIAppID string
+
+	// ResponseURL is the URL to send deferred responses to, which is valid
+	// for up to 5 responses within 30 minutes.
+	ResponseURL string
+
+	// TriggerID can be used to open a modal in response to the command.
+	TriggerID string
+
+	// Form is the decoded payload, including fields which Command doesn't model.
+	Form url.Values
+}
+
+// Parse decodes the slash command payload of the request r.
+func Parse(r *http.Request) (*Command, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, err
+	}
+
+	cmd := Decode(r.PostForm)
+	if cmd.Command == "" {
+		return nil, ErrNoCommand
+	}
+
+	return cmd, nil
+}
+
+// Decode returns the slash command decoded from the form payload v.
+func Decode(v url.Values) *Command {
+	return &Command{
+		Command:             v.Get("command"),
+		Text:                v.Get("text"),
+		Args:                v.Get("text"),
+		UserID:              v.Get("user_id"),
+		UserName:            v.Get("user_name"),
+		ChannelID:           v.Get("channel_id"),
+		ChannelName:         v.Get("channel_name"),
+		TeamID:              v.Get("team_id"),
+		TeamDomain:          v.Get("team_domain"),
+		EnterpriseID:        v.Get("enterprise_id"),
+		EnterpriseName:      v.Get("enterprise_name"),
+		IsEnterpriseInstall: v.Get("is_enterprise_install") == "true",
+		APIAppID:            v.Get("api_app_id"),
+		ResponseURL:         v.Get("response_url"),
+		TriggerID:           v.Get("trigger_id"),
+		Form:                v,
+	}
+}
+
+// Respond sends resp to the command's ResponseURL, using a webhook client
+// configured by opts.
+func (c *Command) Respond(ctx context.Context, resp *Response, opts ...slack.Option) error {
+	if c.ResponseURL == "" {
+		return ErrNoResponseURL
+	}
+
+	return webhook.New(c.ResponseURL, opts...).SendContext(ctx, c.ResponseURL, resp, &slack.Response{})
+}
@@ -0,0 +1,98 @@
+package slash_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/multiplay/go-slack/chat"
+	"github.com/multiplay/go-slack/slacktest"
+	. "github.com/multiplay/go-slack/slash"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	v := url.Values{
+		"token":                 {"gIkuvaNzQIHg97ATvDxqgjtO"},
+		"team_id":               {"T0001"},
+		"team_domain":           {"example"},
+		"enterprise_id":         {"E0001"},
+		"enterprise_name":       {"Globular Construct Inc"},
+		"is_enterprise_install": {"true"},
+		"channel_id":            {"C2147483705"},
+		"channel_name":          {"test"},
+		"user_id":               {"U2147483697"},
+		"user_name":             {"Steve"},
+		"command":               {"/weather"},
+		"text":                  {"94070"},
+		"api_app_id":            {"A123456"},
+		"response_url":          {"https://hooks.slack.com/commands/1234/5678"},
+		"trigger_id":            {"13345224609.738474920.8088930838d88f008e0"},
+	}
+	r := httptest.NewRequest(http.MethodPost, "/slack/commands", strings.NewReader(v.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	cmd, err := Parse(r)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, &Command{
+		Command:             "/weather",
+		Text:                "94070",
+		Args:                "94070",
+		UserID:              "U2147483697",
+		UserName:            "Steve",
+		ChannelID:           "C2147483705",
+		ChannelName:         "test",
+		TeamID:              "T0001",
+		TeamDomain:          "example",
+		EnterpriseID:        "E0001",
+		EnterpriseName:      "Globular Construct Inc",
+		IsEnterpriseInstall: true,
+		APIAppID:            "A123456",
+		ResponseURL:         "https://hooks.slack.com/commands/1234/5678",
+		TriggerID:           "13345224609.738474920.8088930838d88f008e0",
+		Form:                v,
+	}, cmd)
+}
+
+func TestParseNoCommand(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/slack/commands", strings.NewReader("text=hello"))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	_, err := Parse(r)
+	assert.Equal(t, ErrNoCommand, err)
+}
+
+func TestRespond(t *testing.T) {
+	s := slacktest.NewServer()
+	defer s.Close()
+
+	cmd := &Command{Command: "/weather", ResponseURL: s.WebhookURL()}
+	resp := InChannel(&chat.Message{Text: "sunny"})
+	resp.ReplaceOriginal = true
+	if !assert.NoError(t, cmd.Respond(context.Background(), resp)) {
+		return
+	}
+
+	req, ok := s.LastRequest()
+	if !assert.True(t, ok) {
+		return
+	}
+	assert.JSONEq(t, `{"text":"sunny","response_type":"in_channel","replace_original":true}`, string(req.Body))
+
+	got := &Response{}
+	if !assert.NoError(t, req.Decode(got)) {
+		return
+	}
+	assert.Equal(t, resp, got)
+}
+
+func TestRespondNoResponseURL(t *testing.T) {
+	cmd := &Command{Command: "/weather"}
+	assert.Equal(t, ErrNoResponseURL, cmd.Respond(context.Background(), Ephemeral(&chat.Message{Text: "sunny"})))
+}
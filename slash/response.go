@@ -0,0 +1,119 @@
+package slash
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/multiplay/go-slack/chat"
+)
+
+const (
+	// ResponseTypeInChannel is the response type of responses visible to everyone in the channel.
+	ResponseTypeInChannel = "in_channel"
+
+	// ResponseTypeEphemeral is the response type of responses only visible
+	// to the user who invoked the command.
+	ResponseTypeEphemeral = "ephemeral"
+)
+
+// DeferredFunc creates a deferred response to a command, which is sent to
+// its response_url. If it returns a nil Response nothing is sent.
+type DeferredFunc func(ctx context.Context) (*Response, error)
+
+// Response is a response to a slash command, sent either immediately in
+// reply to the command's request or later to its response_url.
+//
+// See: https://api.slack.com/interactivity/handling#message_responses
+type Response struct {
+	chat.Message
+
+	// ResponseType is the visibility of the response, ResponseTypeInChannel
+	// or ResponseTypeEphemeral which is the default.
+	ResponseType string
+
+	// ReplaceOriginal if true replaces the previous response sent to the
+	// response_url instead of posting a new message.
+	ReplaceOriginal bool
+
+	// DeleteOriginal if true deletes the previous response sent to the response_url.
+	DeleteOriginal bool
+
+	// deferred if not nil is called after the response is sent to create a
+	// response which is sent to the response_url.
+	deferred DeferredFunc
+}
+
+// InChannel returns a response which sends m to everyone in the channel.
+func InChannel(m *chat.Message) *Response {
+	return &Response{Message: *m, ResponseType: ResponseTypeInChannel}
+}
+
+// Ephemeral returns a response which sends m only to the user who invoked the command.
+func Ephemeral(m *chat.Message) *Response {
+	return &Response{Message: *m, ResponseType: ResponseTypeEphemeral}
+}
+
+// Defer returns a response which acknowledges the command with ack, which
+// may be nil to send nothing, and then calls fn in the background and sends
+// the response it creates to the command's response_url.
+// Use it for commands which may take longer than the 3 seconds slack waits
+// for a response.
+func Defer(ack *Response, fn DeferredFunc) *Response {
+	r := &Response{}
+	if ack != nil {
+		*r = *ack
+	}
+	r.deferred = fn
+
+	return r
+}
+
+// MarshalJSON implements json.Marshaler.
+func (r Response) MarshalJSON() ([]byte, error) {
+	b, err := json.Marshal(r.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+	if r.ResponseType != "" {
+		fields["response_type"], _ = json.Marshal(r.ResponseType)
+	}
+	if r.ReplaceOriginal {
+		fields["replace_original"] = json.RawMessage("true")
+	}
+	if r.DeleteOriginal {
+		fields["delete_original"] = json.RawMessage("true")
+	}
+
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (r *Response) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &r.Message); err != nil {
+		return err
+	}
+
+	var v struct {
+		ResponseType    string `json:"response_type"`
+		ReplaceOriginal bool   `json:"replace_original"`
+		DeleteOriginal  bool   `json:"delete_original"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	r.ResponseType, r.ReplaceOriginal, r.DeleteOriginal = v.ResponseType, v.ReplaceOriginal, v.DeleteOriginal
+
+	for _, k := range []string{"response_type", "replace_original", "delete_original"} {
+		delete(r.Message.Extra, k)
+	}
+	if len(r.Message.Extra) == 0 {
+		r.Message.Extra = nil
+	}
+
+	return nil
+}
@@ -0,0 +1,244 @@
+// Package slash provides an http.Handler which receives slack slash commands.
+//
+// Requests are verified using the app's signing secret with the signature
+// package, decoded into a Command and routed to the handler registered for
+// the command and its subcommand, the first word of the command's text e.g.
+//
+//	r := slash.NewRouter(slash.Config{SigningSecret: secret})
+//	r.Handle("/deploy", "status", func(ctx context.Context, cmd *slash.Command) (*slash.Response, error) {
+//		return slash.Ephemeral(&chat.Message{Text: "all good"}), nil
+//	})
+//	http.Handle("/slack/commands", r)
+//
+// Handlers respond immediately by returning a Response or, for work which
+// takes longer than the 3 seconds slack waits, use Defer to send the
+// response to the command's response_url later.
+//
+// See: https://api.slack.com/interactivity/slash-commands
+package slash
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/multiplay/go-slack"
+	"github.com/multiplay/go-slack/chat"
+	"github.com/multiplay/go-slack/signature"
+)
+
+// ResponseURLLifetime is how long a command's response_url remains valid,
+// which limits the time a deferred response has to complete.
+const ResponseURLLifetime = 30 * time.Minute
+
+// Config is the configuration of a Router.
+type Config struct {
+	// SigningSecret is the app's signing secret used to verify requests.
+	// If it's empty all requests are rejected.
+	SigningSecret string
+
+	// MaxAge is the maximum difference between a request's timestamp and
+	// the current time, after which it's rejected to prevent replay attacks.
+	// Defaults to signature.DefaultMaxAge.
+	MaxAge time.Duration
+
+	// Options are the options of the webhook client used to send deferred
+	// responses to response_url.
+	Options []slack.Option
+
+	// OnError if not nil is called with errors returned by handlers and
+	// deferred responses.
+	OnError func(cmd *Command, err error)
+}
+
+// SetConfigDefaults sets defaults on the configuration if needed to ensure the cfg is valid.
+func SetConfigDefaults(cfg *Config) {
+	if cfg.MaxAge == 0 {
+		cfg.MaxAge = signature.DefaultMaxAge
+	}
+}
+
+// HandlerFunc handles a slash command.
+// If it returns an error the request fails with http.StatusInternalServerError,
+// if it returns a nil Response the command is acknowledged without a response.
+type HandlerFunc func(ctx context.Context, cmd *Command) (*Response, error)
+
+// Router is an http.Handler which routes slash commands to handlers by
+// command and subcommand.
+type Router struct {
+	Config
+	handler http.Handler
+	wg      sync.WaitGroup
+
+	mtx      sync.RWMutex
+	commands map[string]map[string]HandlerFunc
+}
+
+// NewRouter returns a new Router with the given configuration.
+// It ensures that the cfg is valid by calling SetConfigDefaults on the cfg.
+func NewRouter(cfg Config) *Router {
+	SetConfigDefaults(&cfg)
+
+	rt := &Router{Config: cfg, commands: make(map[string]map[string]HandlerFunc)}
+	v := signature.NewVerifier(signature.Config{SigningSecret: cfg.SigningSecret, MaxAge: cfg.MaxAge})
+	rt.handler = v.Middleware(http.HandlerFunc(rt.serve))
+
+	return rt
+}
+
+// Handle registers fn as the handler for subcommand of command e.g. /deploy,
+// replacing any existing handler. Subcommands are matched case insensitively.
+// The handler for an empty subcommand handles invocations which don't match
+// any other subcommand of command, otherwise they're sent an ephemeral usage
+// response listing the available subcommands.
+func (rt *Router) Handle(command, subcommand string, fn HandlerFunc) {
+	rt.mtx.Lock()
+	defer rt.mtx.Unlock()
+
+	subs, ok := rt.commands[command]
+	if !ok {
+		subs = make(map[string]HandlerFunc)
+		rt.commands[command] = subs
+	}
+	subs[strings.ToLower(subcommand)] = fn
+}
+
+// ServeHTTP implements http.Handler.
+// Requests which fail verification are rejected with http.StatusUnauthorized.
+func (rt *Router) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	rt.handler.ServeHTTP(w, r)
+}
+
+// Wait waits for all deferred responses to complete.
+func (rt *Router) Wait() {
+	rt.wg.Wait()
+}
+
+// serve handles a verified request.
+func (rt *Router) serve(w http.ResponseWriter, r *http.Request) {
+	cmd, err := Parse(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := rt.route(cmd)(r.Context(), cmd)
+	if err != nil {
+		rt.error(cmd, err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	} else if resp == nil {
+		return
+	}
+
+	body, err := json.Marshal(resp)
+	if err != nil {
+		rt.error(cmd, err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if string(body) != "{}" {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(body)
+	}
+
+	if resp.deferred != nil {
+		rt.wg.Add(1)
+		go rt.respond(cmd, resp.deferred)
+	}
+}
+
+// route returns the handler for cmd, setting its Subcommand and Args.
+func (rt *Router) route(cmd *Command) HandlerFunc {
+	rt.mtx.RLock()
+	defer rt.mtx.RUnlock()
+
+	subs, ok := rt.commands[cmd.Command]
+	if !ok {
+		return rt.usage(nil)
+	}
+
+	text := strings.TrimSpace(cmd.Text)
+	sub, args := text, ""
+	if i := strings.IndexFunc(text, isSpace); i != -1 {
+		sub, args = text[:i], strings.TrimSpace(text[i:])
+	}
+
+	if fn, ok := subs[strings.ToLower(sub)]; ok && sub != "" {
+		cmd.Subcommand, cmd.Args = sub, args
+		return fn
+	}
+
+	cmd.Subcommand, cmd.Args = "", text
+	if fn, ok := subs[""]; ok {
+		return fn
+	}
+
+	return rt.usage(subs)
+}
+
+// usage returns a handler which responds with the available subcommands subs.
+func (rt *Router) usage(subs map[string]HandlerFunc) HandlerFunc {
+	names := make([]string, 0, len(subs))
+	for k := range subs {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	return func(ctx context.Context, cmd *Command) (*Response, error) {
+		if len(names) == 0 {
+			return Ephemeral(&chat.Message{Text: fmt.Sprintf("Unknown command %s", cmd.Command)}), nil
+		}
+
+		sub := strings.Fields(cmd.Text)
+		if len(sub) == 0 {
+			return Ephemeral(&chat.Message{
+				Text: fmt.Sprintf("Usage: %s <%s>", cmd.Command, strings.Join(names, "|")),
+			}), nil
+		}
+
+		return Ephemeral(&chat.Message{
+			Text: fmt.Sprintf("Unknown subcommand %q, usage: %s <%s>", sub[0], cmd.Command, strings.Join(names, "|")),
+		}), nil
+	}
+}
+
+// respond calls fn and sends its response to the response_url of cmd.
+func (rt *Router) respond(cmd *Command, fn DeferredFunc) {
+	defer rt.wg.Done()
+
+	ctx, cancel := context.WithTimeout(context.Background(), ResponseURLLifetime)
+	defer cancel()
+
+	resp, err := fn(ctx)
+	if err == nil && resp != nil {
+		err = cmd.Respond(ctx, resp, rt.Options...)
+	}
+	if err != nil {
+		rt.error(cmd, err)
+	}
+}
+
+// error passes err to the OnError callback if configured.
+func (rt *Router) error(cmd *Command, err error) {
+	if rt.OnError != nil {
+		rt.OnError(cmd, err)
+	}
+}
+
+// isSpace reports whether r separates a subcommand from its arguments.
+func isSpace(r rune) bool {
+	return r == ' ' || r == '\t' || r == '\n'
+}
@@ -0,0 +1,211 @@
+package slash_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiplay/go-slack/chat"
+	"github.com/multiplay/go-slack/signature"
+	"github.com/multiplay/go-slack/slacktest"
+	. "github.com/multiplay/go-slack/slash"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const secret = "8f742231b10e8888abcd99yyyzzz85a5"
+
+// post sends the command with text to h signed with key and returns the response.
+func post(h http.Handler, key, command, text, responseURL string) *httptest.ResponseRecorder {
+	v := url.Values{
+		"command":      {command},
+		"text":         {text},
+		"user_id":      {"U123"},
+		"channel_id":   {"C123"},
+		"response_url": {responseURL},
+	}
+	r := httptest.NewRequest(http.MethodPost, "/slack/commands", strings.NewReader(v.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	signature.NewSigner(key).SignAt(r, time.Now())
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	return w
+}
+
+func TestRouter(t *testing.T) {
+	rt := NewRouter(Config{SigningSecret: secret})
+	var got *Command
+	rt.Handle("/deploy", "status", func(ctx context.Context, cmd *Command) (*Response, error) {
+		got = cmd
+		return Ephemeral(&chat.Message{Text: "all good"}), nil
+	})
+	rt.Handle("/deploy", "", func(ctx context.Context, cmd *Command) (*Response, error) {
+		got = cmd
+		return InChannel(&chat.Message{Text: "deploying " + cmd.Args}), nil
+	})
+
+	w := post(rt, secret, "/deploy", "Status  api\tweb", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"text":"all good","response_type":"ephemeral"}`, w.Body.String())
+	if assert.NotNil(t, got) {
+		assert.Equal(t, "Status", got.Subcommand)
+		assert.Equal(t, "api\tweb", got.Args)
+		assert.Equal(t, "Status  api\tweb", got.Text)
+		assert.Equal(t, "U123", got.UserID)
+	}
+
+	w = post(rt, secret, "/deploy", " api web", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"text":"deploying api web","response_type":"in_channel"}`, w.Body.String())
+	if assert.NotNil(t, got) {
+		assert.Empty(t, got.Subcommand)
+		assert.Equal(t, "api web", got.Args)
+	}
+}
+
+func TestRouterUsage(t *testing.T) {
+	rt := NewRouter(Config{SigningSecret: secret})
+	noop := func(ctx context.Context, cmd *Command) (*Response, error) {
+		return nil, nil
+	}
+	rt.Handle("/deploy", "status", noop)
+	rt.Handle("/deploy", "rollback", noop)
+
+	tests := []struct {
+		command string
+		text    string
+		expect  string
+	}{
+		{"/deploy", "", "Usage: /deploy <rollback|status>"},
+		{"/deploy", "launch api", `Unknown subcommand "launch", usage: /deploy <rollback|status>`},
+		{"/other", "status", "Unknown command /other"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.command+" "+tc.text, func(t *testing.T) {
+			w := post(rt, secret, tc.command, tc.text, "")
+			assert.Equal(t, http.StatusOK, w.Code)
+
+			resp := &Response{}
+			if !assert.NoError(t, resp.UnmarshalJSON(w.Body.Bytes())) {
+				return
+			}
+			assert.Equal(t, ResponseTypeEphemeral, resp.ResponseType)
+			assert.Equal(t, tc.expect, resp.Text)
+		})
+	}
+}
+
+func TestRouterAck(t *testing.T) {
+	rt := NewRouter(Config{SigningSecret: secret})
+	rt.Handle("/deploy", "", func(ctx context.Context, cmd *Command) (*Response, error) {
+		return nil, nil
+	})
+
+	w := post(rt, secret, "/deploy", "", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Body.String())
+}
+
+func TestRouterError(t *testing.T) {
+	var mtx sync.Mutex
+	var errs []error
+	rt := NewRouter(Config{
+		SigningSecret: secret,
+		OnError: func(cmd *Command, err error) {
+			mtx.Lock()
+			defer mtx.Unlock()
+			errs = append(errs, err)
+		},
+	})
+	errFail := errors.New("failed")
+	rt.Handle("/deploy", "", func(ctx context.Context, cmd *Command) (*Response, error) {
+		return nil, errFail
+	})
+
+	w := post(rt, secret, "/deploy", "", "")
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Equal(t, []error{errFail}, errs)
+}
+
+func TestRouterVerify(t *testing.T) {
+	rt := NewRouter(Config{SigningSecret: secret})
+	called := false
+	rt.Handle("/deploy", "", func(ctx context.Context, cmd *Command) (*Response, error) {
+		called = true
+		return nil, nil
+	})
+
+	w := post(rt, "wrong", "/deploy", "", "")
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.False(t, called)
+
+	r := httptest.NewRequest(http.MethodGet, "/slack/commands", nil)
+	w = httptest.NewRecorder()
+	rt.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	assert.Equal(t, http.MethodPost, w.Header().Get("Allow"))
+}
+
+func TestRouterDefer(t *testing.T) {
+	s := slacktest.NewServer()
+	defer s.Close()
+
+	rt := NewRouter(Config{SigningSecret: secret})
+	rt.Handle("/deploy", "", func(ctx context.Context, cmd *Command) (*Response, error) {
+		ack := InChannel(&chat.Message{})
+		return Defer(ack, func(ctx context.Context) (*Response, error) {
+			return InChannel(&chat.Message{Text: "deployed " + cmd.Args}), nil
+		}), nil
+	})
+
+	w := post(rt, secret, "/deploy", "api", s.WebhookURL())
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"response_type":"in_channel"}`, w.Body.String())
+
+	rt.Wait()
+	reqs := s.Requests()
+	if !assert.Len(t, reqs, 1) {
+		return
+	}
+	assert.JSONEq(t, `{"text":"deployed api","response_type":"in_channel"}`, string(reqs[0].Body))
+}
+
+func TestRouterDeferError(t *testing.T) {
+	s := slacktest.NewServer()
+	defer s.Close()
+	s.Fail(slacktest.Failure{Error: "expired_url", StatusCode: http.StatusNotFound})
+
+	var mtx sync.Mutex
+	var errs []error
+	rt := NewRouter(Config{
+		SigningSecret: secret,
+		OnError: func(cmd *Command, err error) {
+			mtx.Lock()
+			defer mtx.Unlock()
+			errs = append(errs, err)
+		},
+	})
+	rt.Handle("/deploy", "", func(ctx context.Context, cmd *Command) (*Response, error) {
+		return Defer(nil, func(ctx context.Context) (*Response, error) {
+			return Ephemeral(&chat.Message{Text: "done"}), nil
+		}), nil
+	})
+
+	w := post(rt, secret, "/deploy", "", s.WebhookURL())
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Body.String())
+
+	rt.Wait()
+	mtx.Lock()
+	defer mtx.Unlock()
+	assert.Len(t, errs, 1)
+}
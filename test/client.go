@@ -1,22 +1,36 @@
-// Package test provides a slack client implementation which uses the slack api.test endpoint
-// so is suitable to testing.
+// Package test provides a slack client implementation which uses the api.test
+// endpoint of an in-process slacktest.Server so is suitable to testing offline.
 //
 // See: https://api.slack.com/methods/api.test
 package test
 
 import (
+	"context"
 	"net/url"
+	"path"
 
+	"github.com/multiplay/go-slack"
+	"github.com/multiplay/go-slack/api"
 	"github.com/multiplay/go-slack/webhook"
 )
 
 // New returns a new slack.Client that can be used for testing.
 func New() *webhook.Client {
-	return webhook.New(Endpoint)
+	return webhook.New(URL())
 }
 
 // NewError returns a new slack.Client that can be used for testing which errors with err.
 func NewError(err string) *webhook.Client {
 	v := url.Values{"error": {err}}
-	return webhook.New(Endpoint + "?" + v.Encode())
+	return webhook.New(URL() + "?" + v.Encode())
+}
+
+// NewAPI returns a new slack.ContextClient that can be used for testing calls
+// to web API methods. Requests are sent to the method of Server named by the
+// last element of the requested url e.g. chat.postMessage.
+func NewAPI() slack.ContextClient {
+	c := api.New("xoxb-test")
+	return slack.ClientFunc(func(ctx context.Context, u string, msg, resp interface{}) error {
+		return c.SendContext(ctx, Server().APIURL(path.Base(u)), msg, resp)
+	})
 }
@@ -12,16 +12,31 @@ import (
 
 func TestNew(t *testing.T) {
 	c := New()
-	assert.Equal(t, c.URL, Endpoint)
+	assert.Equal(t, c.URL, URL())
 }
 
 func TestNewError(t *testing.T) {
 	err := "my error"
 	c := NewError(err)
-	assert.Contains(t, c.URL, Endpoint)
+	assert.Contains(t, c.URL, URL())
 	assert.Contains(t, c.URL, url.Values{"error": {err}}.Encode())
 }
 
+func TestNewAPI(t *testing.T) {
+	c := NewAPI()
+	resp := &slack.Response{}
+	if !assert.NoError(t, c.Send("https://slack.com/api/api.test", map[string]string{"a": "b"}, resp)) {
+		return
+	}
+	assert.True(t, resp.OK)
+
+	r, ok := Server().LastRequest()
+	if assert.True(t, ok) {
+		assert.Equal(t, "api.test", r.Method)
+		assert.Equal(t, "Bearer xoxb-test", r.Header.Get("Authorization"))
+	}
+}
+
 func ExampleNew() {
 	c := New()
 	msg := struct {
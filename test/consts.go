@@ -1,6 +1,35 @@
 package test
 
+import (
+	"sync"
+
+	"github.com/multiplay/go-slack/slacktest"
+)
+
 const (
-	// Endpoint is the slack endpoint which can be used for testing calling code.
+	// Endpoint is the real slack api.test endpoint.
+	//
+	// Deprecated: Endpoint calls slack, use URL to test calling code offline.
 	Endpoint = "https://slack.com/api/api.test"
 )
+
+var (
+	server     *slacktest.Server
+	serverOnce sync.Once
+)
+
+// Server returns the in-process fake slack server used by the test clients,
+// starting it on first use. It's shared by all users of the package.
+func Server() *slacktest.Server {
+	serverOnce.Do(func() {
+		server = slacktest.NewServer()
+	})
+
+	return server
+}
+
+// URL returns the api.test endpoint of Server which can be used for testing
+// calling code offline.
+func URL() string {
+	return Server().APIURL("api.test")
+}
@@ -5,6 +5,7 @@ package webhook
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"io/ioutil"
@@ -16,48 +17,71 @@ import (
 
 // Client is a slack webhook client for posting messages using a webhook URL.
 type Client struct {
+	slack.HTTPConfig
+
 	// URL is the webhook URL to use
 	URL string
 }
 
-// New returns a new Client which sends request using the webhook URL.
-func New(url string) *Client {
-	return &Client{URL: url}
+// New returns a new Client which sends request using the webhook URL
+// configured by opts.
+func New(url string, opts ...slack.Option) *Client {
+	c := &Client{URL: url}
+	c.Apply(opts...)
+
+	return c
 }
 
 // Send sends the request to slack using the webhook protocol.
 // The url parameter only exists to satisfy the slack.Client interface
 // and is not used by the webhook Client.
 func (c *Client) Send(url string, msg, resp interface{}) error {
+	return c.SendContext(context.Background(), url, msg, resp)
+}
+
+// SendContext sends the request to slack using the webhook protocol
+// using ctx to control the lifetime of the request.
+// As with Send the url parameter is not used.
+func (c *Client) SendContext(ctx context.Context, url string, msg, resp interface{}) error {
 	b, err := json.Marshal(msg)
 	if err != nil {
 		return err
 	}
 
-	r, err := http.Post(c.URL, "application/json; charset=utf-8", bytes.NewReader(b))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.URL, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	r, err := c.Do(req)
 	if err != nil {
 		return err
 	}
 	defer r.Body.Close()
 
 	if r.StatusCode != http.StatusOK {
-		b, _ := ioutil.ReadAll(r.Body)
-		return slack.NewError(r.StatusCode, string(b))
+		return slack.NewHTTPError(r)
+	}
+
+	b, err = ioutil.ReadAll(r.Body)
+	if err != nil {
+		return err
 	}
 
 	var body io.Reader
-	if strings.HasPrefix(c.URL, "https://hooks.slack.com") {
+	if strings.HasPrefix(c.URL, "https://hooks.slack.com") || bytes.Equal(bytes.TrimSpace(b), []byte("ok")) {
 		// Work around webhooks not returning JSON as originally documented
 		// by treating all StatusOK as success.
 		body = bytes.NewReader([]byte(`{"ok":true}`))
 	} else {
 		// This is required for compatibility with API test endpoint.
-		body = r.Body
+		body = bytes.NewReader(b)
 	}
 
 	dec := json.NewDecoder(body)
 	if err := dec.Decode(resp); err != nil {
-		return slack.NewError(r.StatusCode, err.Error())
+		return slack.NewDecodeError(r.StatusCode, err)
 	}
 
 	if sr, ok := resp.(slack.SendResponse); !ok {